@@ -18,15 +18,18 @@ func getScheduledBackupClient(d *schema.ResourceData, meta any) (*cloudbackup.Se
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for scheduled backup api: %w", err))
 	}
 
-	err = validateRegion(selvpcClient, DataProtectV2, region)
+	err = config.validateServiceRegion(selvpcClient, DataProtectV2, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
 
-	endpoint, err := selvpcClient.Catalog.GetEndpoint(DataProtectV2, region)
+	endpoint, err := config.getEndpoint(selvpcClient, DataProtectV2, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init client: %w", err))
 	}
 
-	return cloudbackup.NewClientV2(selvpcClient.GetXAuthToken(), endpoint.URL), nil
+	client := cloudbackup.NewClientV2(config.GetXAuthToken(selvpcClient), endpoint)
+	client.HTTPClient = config.GetHTTPClient(DataProtectV2)
+
+	return client, nil
 }
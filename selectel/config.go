@@ -2,17 +2,22 @@ package selectel
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient"
-)
-
-var (
-	cfgSingletone *Config
-	once          sync.Once
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/clients"
+	clientservices "github.com/selectel/go-selvpcclient/v4/selvpcclient/clients/services"
+	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httpclient"
 )
 
 // Config contains all available configuration options.
@@ -27,34 +32,138 @@ type Config struct {
 	Password       string
 	UserDomainName string
 	DomainName     string
-	clientsCache   map[string]*selvpcclient.Client
-	lock           sync.Mutex
+
+	AuthToken                   string
+	ApplicationCredentialID     string
+	ApplicationCredentialSecret string
+
+	MaxRetries     int
+	RetryWaitMin   time.Duration
+	RetryWaitMax   time.Duration
+	RequestTimeout time.Duration
+
+	// Endpoints contains endpoints overrides by service type.
+	Endpoints map[string]string
+
+	// HTTPLogging enables debug logs of API requests and responses.
+	HTTPLogging bool
+
+	clientsCache    map[string]*selvpcclient.Client
+	identityClients map[*selvpcclient.Client]*gophercloud.ServiceClient
+	lock            sync.Mutex
+
+	httpClients map[string]*http.Client
 
 	UserAgent string
 }
 
+// getConfig builds a new Config for every configured provider instance so
+// aliased providers never share credentials or cached clients.
 func getConfig(d *schema.ResourceData, userAgent string) (*Config, diag.Diagnostics) {
-	once.Do(func() {
-		cfgSingletone = &Config{
-			Username:   d.Get("username").(string),
-			Password:   d.Get("password").(string),
-			DomainName: d.Get("domain_name").(string),
-			AuthURL:    d.Get("auth_url").(string),
-			AuthRegion: d.Get("auth_region").(string),
-			UserAgent:  userAgent,
+	config := &Config{
+		Username:                    d.Get("username").(string),
+		Password:                    d.Get("password").(string),
+		DomainName:                  d.Get("domain_name").(string),
+		AuthURL:                     d.Get("auth_url").(string),
+		AuthRegion:                  d.Get("auth_region").(string),
+		AuthToken:                   d.Get("auth_token").(string),
+		ApplicationCredentialID:     d.Get("application_credential_id").(string),
+		ApplicationCredentialSecret: d.Get("application_credential_secret").(string),
+		MaxRetries:                  d.Get("max_retries").(int),
+		Endpoints:                   expandProviderEndpoints(d.Get("endpoints").([]any)),
+		HTTPLogging:                 d.Get("enable_http_logging").(bool),
+		UserAgent:                   userAgent,
+		clientsCache:                map[string]*selvpcclient.Client{},
+		identityClients:             map[*selvpcclient.Client]*gophercloud.ServiceClient{},
+	}
+	if v, ok := d.GetOk("user_domain_name"); ok {
+		config.UserDomainName = v.(string)
+	}
+	if v, ok := d.GetOk("project_id"); ok {
+		config.ProjectID = v.(string)
+	}
+	if v, ok := d.GetOk("region"); ok {
+		config.Region = v.(string)
+	}
+
+	for key, field := range map[string]*time.Duration{
+		"retry_wait_min":  &config.RetryWaitMin,
+		"retry_wait_max":  &config.RetryWaitMax,
+		"request_timeout": &config.RequestTimeout,
+	} {
+		duration, err := time.ParseDuration(d.Get(key).(string))
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("error parsing %s: %w", key, err))
 		}
-		if v, ok := d.GetOk("user_domain_name"); ok {
-			cfgSingletone.UserDomainName = v.(string)
+		*field = duration
+	}
+	if config.RetryWaitMin > config.RetryWaitMax {
+		return nil, diag.FromErr(errors.New("retry_wait_min must not be greater than retry_wait_max"))
+	}
+
+	if profileName, ok := d.GetOk("profile"); ok {
+		profile, err := loadCloudsProfile(d.Get("config_file").(string), profileName.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
-		if v, ok := d.GetOk("project_id"); ok {
-			cfgSingletone.ProjectID = v.(string)
+		config.applyCloudsProfile(profile)
+	}
+
+	if config.AuthURL == "" {
+		return nil, diag.FromErr(errors.New("auth_url must be set in the provider configuration or in the profile"))
+	}
+	if config.AuthRegion == "" {
+		return nil, diag.FromErr(errors.New("auth_region must be set in the provider configuration or in the profile"))
+	}
+
+	if err := config.validateAuth(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return config, nil
+}
+
+// validateAuth checks that exactly one authentication method is configured
+// and that it has all the fields it needs.
+func (c *Config) validateAuth() error {
+	var methods []string
+	if c.Password != "" {
+		methods = append(methods, "password")
+	}
+	if c.AuthToken != "" {
+		methods = append(methods, "auth_token")
+	}
+	if c.ApplicationCredentialID != "" || c.ApplicationCredentialSecret != "" {
+		methods = append(methods, "application_credential_id")
+	}
+
+	switch len(methods) {
+	case 0:
+		return errors.New("one of password, auth_token or application_credential_id must be set")
+	case 1:
+	default:
+		return fmt.Errorf("only one authentication method can be used, got: %s", strings.Join(methods, ", "))
+	}
+
+	switch {
+	case c.Password != "":
+		if c.Username == "" {
+			return errors.New("username must be set when authenticating with password")
 		}
-		if v, ok := d.GetOk("region"); ok {
-			cfgSingletone.Region = v.(string)
+		if c.DomainName == "" {
+			return errors.New("domain_name must be set when authenticating with password")
 		}
-	})
+	case c.AuthToken != "":
+		if c.DomainName == "" {
+			return errors.New("domain_name must be set when authenticating with auth_token")
+		}
+	default:
+		if c.ApplicationCredentialID == "" || c.ApplicationCredentialSecret == "" {
+			return errors.New("application_credential_id and application_credential_secret must be set together")
+		}
+	}
 
-	return cfgSingletone, nil
+	return nil
 }
 
 func (c *Config) GetSelVPCClient() (*selvpcclient.Client, error) {
@@ -71,6 +180,22 @@ func (c *Config) GetSelVPCClientWithProjectScope(projectID string) (*selvpcclien
 		return client, nil
 	}
 
+	if c.AuthToken != "" || c.ApplicationCredentialID != "" {
+		client, identityClient, err := c.newSelVPCClientWithAuthOptions(projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.identityClients == nil {
+			c.identityClients = map[*selvpcclient.Client]*gophercloud.ServiceClient{}
+		}
+		c.identityClients[client] = identityClient
+
+		c.cacheSelVPCClient(clientsCacheKey, client)
+
+		return client, nil
+	}
+
 	opts := &selvpcclient.ClientOptions{
 		DomainName:     c.DomainName,
 		Username:       c.Username,
@@ -87,11 +212,198 @@ func (c *Config) GetSelVPCClientWithProjectScope(projectID string) (*selvpcclien
 		return nil, err
 	}
 
+	c.cacheSelVPCClient(clientsCacheKey, client)
+
+	return client, nil
+}
+
+// httpLoggingSensitiveKeys contains JSON keys that are redacted in the HTTP
+// logs of a service in addition to credentials and secrets.
+var httpLoggingSensitiveKeys = map[string][]string{
+	SecretsManager: {"value"},
+}
+
+// httpRetryServerErrors contains services whose clients retried all 5xx
+// responses before the provider-wide retry policy was added.
+var httpRetryServerErrors = map[string]bool{
+	DomainsV1:    true,
+	PrivateDNS:   true,
+	PublicNetAPI: true,
+}
+
+// GetHTTPClient returns the HTTP client for the service clients of the
+// provider instance. It follows the retry and timeout policy of the config.
+// If HTTP logging is enabled, every service gets its own client that writes
+// logs to the tflog subsystem of the service.
+func (c *Config) GetHTTPClient(serviceType string) *http.Client {
+	var logging *httpclient.LoggingOptions
+	if c.HTTPLogging {
+		logging = &httpclient.LoggingOptions{
+			Subsystem:     httpLoggingSubsystem(serviceType),
+			SensitiveKeys: httpLoggingSensitiveKeys[serviceType],
+		}
+	}
+
+	retryServerErrors := httpRetryServerErrors[serviceType]
+
+	cacheKey := ""
+	switch {
+	case logging != nil:
+		cacheKey = serviceType
+	case retryServerErrors:
+		cacheKey = "retry_server_errors"
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if client, ok := c.httpClients[cacheKey]; ok {
+		return client
+	}
+
+	client := httpclient.New(httpclient.Options{
+		MaxRetries:        c.MaxRetries,
+		RetryWaitMin:      c.RetryWaitMin,
+		RetryWaitMax:      c.RetryWaitMax,
+		RequestTimeout:    c.RequestTimeout,
+		Logging:           logging,
+		RetryServerErrors: retryServerErrors,
+	})
+
+	if c.httpClients == nil {
+		c.httpClients = map[string]*http.Client{}
+	}
+	c.httpClients[cacheKey] = client
+
+	return client
+}
+
+// GetXAuthToken returns the Keystone token of a client created by the config.
+// Clients authenticated with a token or an application credential keep their
+// identity service client in the config instead of the selvpcclient.Client.
+func (c *Config) GetXAuthToken(client *selvpcclient.Client) string {
+	c.lock.Lock()
+	identityClient, ok := c.identityClients[client]
+	c.lock.Unlock()
+
+	if ok {
+		return identityClient.Token()
+	}
+
+	return client.GetXAuthToken()
+}
+
+func (c *Config) cacheSelVPCClient(key string, client *selvpcclient.Client) {
 	if c.clientsCache == nil {
 		c.clientsCache = map[string]*selvpcclient.Client{}
 	}
 
-	c.clientsCache[clientsCacheKey] = client
+	c.clientsCache[key] = client
+}
 
-	return client, nil
+// authOptions returns Keystone auth options for the token and application
+// credential authentication methods.
+func (c *Config) authOptions(projectID string) gophercloud.AuthOptions {
+	if c.ApplicationCredentialID != "" {
+		// Application credentials are always bound to the project they were
+		// issued for, so the scope can't be requested explicitly.
+		return gophercloud.AuthOptions{
+			IdentityEndpoint:            c.AuthURL,
+			ApplicationCredentialID:     c.ApplicationCredentialID,
+			ApplicationCredentialSecret: c.ApplicationCredentialSecret,
+			AllowReauth:                 true,
+		}
+	}
+
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: c.AuthURL,
+		TokenID:          c.AuthToken,
+		Scope: &gophercloud.AuthScope{
+			ProjectID: projectID,
+		},
+	}
+
+	// If project scope is not set, we use domain scope.
+	if projectID == "" {
+		opts.Scope.DomainName = c.DomainName
+	}
+
+	return opts
+}
+
+// checkApplicationCredentialScope returns an error if the token of the
+// application credential isn't scoped to the requested project. The scope of
+// application credentials can't be changed, so neither another project nor
+// the account scope can be used with them.
+func checkApplicationCredentialScope(authResult gophercloud.AuthResult, projectID string) error {
+	result, ok := authResult.(interface {
+		ExtractProject() (*tokens.Project, error)
+	})
+	if !ok {
+		return errors.New("unable to get the project of the application credential token")
+	}
+
+	project, err := result.ExtractProject()
+	if err != nil {
+		return fmt.Errorf("unable to get the project of the application credential token: %w", err)
+	}
+	if project == nil {
+		return errors.New("application credential token isn't scoped to a project")
+	}
+
+	if projectID == "" {
+		return fmt.Errorf("application credential is bound to project %s and can't be used for account-scoped requests, "+
+			"use password or auth_token authentication instead", project.ID)
+	}
+	if project.ID != projectID {
+		return fmt.Errorf("application credential is bound to project %s and can't be used for project %s", project.ID, projectID)
+	}
+
+	return nil
+}
+
+func (c *Config) newSelVPCClientWithAuthOptions(projectID string) (*selvpcclient.Client, *gophercloud.ServiceClient, error) {
+	if c.AuthURL == "" || c.AuthRegion == "" {
+		return nil, nil, errors.New("auth_url and auth_region must be set")
+	}
+
+	authProvider, err := openstack.AuthenticatedClient(c.authOptions(projectID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create auth provider, err: %w", err)
+	}
+
+	if c.ApplicationCredentialID != "" {
+		err = checkApplicationCredentialScope(authProvider.GetAuthResult(), projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	identityClient, err := openstack.NewIdentityV3(authProvider, gophercloud.EndpointOpts{
+		Availability: gophercloud.AvailabilityPublic,
+		Region:       c.AuthRegion,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create service client, err: %w", err)
+	}
+
+	identityClient.HTTPClient = *clientservices.NewHTTPClient()
+	if c.UserAgent != "" {
+		identityClient.UserAgent.Prepend(c.UserAgent)
+	}
+
+	catalogService, err := clientservices.NewCatalogService(identityClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize endpoints catalog service, err: %w", err)
+	}
+
+	requestService := clientservices.NewRequestService(identityClient)
+
+	client := &selvpcclient.Client{
+		Resell:       clients.NewResellClient(requestService, catalogService, c.AuthRegion),
+		QuotaManager: clients.NewQuotaManagerClient(requestService, catalogService),
+		Catalog:      catalogService,
+	}
+
+	return client, identityClient, nil
 }
@@ -0,0 +1,125 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const cloudsFileName = "clouds.yaml"
+
+// cloudsFile represents a clouds.yaml compatible file.
+type cloudsFile struct {
+	Clouds map[string]cloudsProfile `yaml:"clouds"`
+}
+
+// cloudsProfile represents a single cloud entry of a clouds.yaml compatible
+// file. Besides the standard keys it supports Selectel specific auth_region
+// and region keys.
+type cloudsProfile struct {
+	Auth       cloudsProfileAuth `yaml:"auth"`
+	RegionName string            `yaml:"region_name"`
+	AuthRegion string            `yaml:"auth_region"`
+	Region     string            `yaml:"region"`
+}
+
+type cloudsProfileAuth struct {
+	AuthURL                     string `yaml:"auth_url"`
+	Username                    string `yaml:"username"`
+	Password                    string `yaml:"password"`
+	Token                       string `yaml:"token"`
+	ApplicationCredentialID     string `yaml:"application_credential_id"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+	DomainName                  string `yaml:"domain_name"`
+	ProjectDomainName           string `yaml:"project_domain_name"`
+	UserDomainName              string `yaml:"user_domain_name"`
+	ProjectID                   string `yaml:"project_id"`
+}
+
+// cloudsFileSearchPaths returns the default locations of clouds.yaml in the
+// order they are looked up by OpenStack clients.
+func cloudsFileSearchPaths() []string {
+	paths := []string{cloudsFileName}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "openstack", cloudsFileName))
+	}
+
+	return append(paths, filepath.Join("/etc", "openstack", cloudsFileName))
+}
+
+func findCloudsFile() (string, error) {
+	for _, path := range cloudsFileSearchPaths() {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", errors.New("unable to find clouds.yaml, set config_file explicitly")
+}
+
+// loadCloudsProfile reads the profile with the given name from the file. If
+// the path is empty, the default clouds.yaml locations are used.
+func loadCloudsProfile(path, name string) (*cloudsProfile, error) {
+	if path == "" {
+		var err error
+		path, err = findCloudsFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+
+	var file cloudsFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+
+	profile, ok := file.Clouds[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q is not found in %s", name, path)
+	}
+
+	return &profile, nil
+}
+
+// applyCloudsProfile fills the config fields that were not set explicitly
+// with the values from the profile. Credentials from the profile are used only
+// if no authentication method is configured explicitly.
+func (c *Config) applyCloudsProfile(p *cloudsProfile) {
+	setIfEmpty := func(field *string, values ...string) {
+		if *field != "" {
+			return
+		}
+		for _, v := range values {
+			if v != "" {
+				*field = v
+
+				return
+			}
+		}
+	}
+
+	setIfEmpty(&c.AuthURL, p.Auth.AuthURL)
+	setIfEmpty(&c.AuthRegion, p.AuthRegion, p.RegionName)
+	setIfEmpty(&c.Region, p.Region, p.RegionName)
+	setIfEmpty(&c.ProjectID, p.Auth.ProjectID)
+	setIfEmpty(&c.DomainName, p.Auth.DomainName, p.Auth.ProjectDomainName, p.Auth.UserDomainName)
+	setIfEmpty(&c.UserDomainName, p.Auth.UserDomainName)
+
+	if c.Password != "" || c.AuthToken != "" || c.ApplicationCredentialID != "" || c.ApplicationCredentialSecret != "" {
+		return
+	}
+
+	setIfEmpty(&c.Username, p.Auth.Username)
+	c.Password = p.Auth.Password
+	c.AuthToken = p.Auth.Token
+	c.ApplicationCredentialID = p.Auth.ApplicationCredentialID
+	c.ApplicationCredentialSecret = p.Auth.ApplicationCredentialSecret
+}
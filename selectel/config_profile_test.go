@@ -0,0 +1,83 @@
+package selectel
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCloudsFile = filepath.Join("testdata", "clouds.yaml")
+
+func TestLoadCloudsProfile(t *testing.T) {
+	profile, err := loadCloudsProfile(testCloudsFile, "first")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://cloud.api.selcloud.ru/identity/v3/", profile.Auth.AuthURL)
+	assert.Equal(t, "first-user", profile.Auth.Username)
+	assert.Equal(t, "first-password", profile.Auth.Password)
+	assert.Equal(t, "first-project", profile.Auth.ProjectID)
+	assert.Equal(t, "ru-1", profile.RegionName)
+}
+
+func TestLoadCloudsProfileNotFound(t *testing.T) {
+	_, err := loadCloudsProfile(testCloudsFile, "unknown")
+	assert.ErrorContains(t, err, `profile "unknown" is not found`)
+
+	_, err = loadCloudsProfile(filepath.Join("testdata", "missing.yaml"), "first")
+	assert.ErrorContains(t, err, "error reading config file")
+}
+
+func TestGetConfigFromProfile(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"config_file": testCloudsFile,
+		"profile":     "first",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.Equal(t, "https://cloud.api.selcloud.ru/identity/v3/", config.AuthURL)
+	assert.Equal(t, "ru-1", config.AuthRegion)
+	assert.Equal(t, "ru-1", config.Region)
+	assert.Equal(t, "111111", config.DomainName)
+	assert.Equal(t, "111111", config.UserDomainName)
+	assert.Equal(t, "first-project", config.ProjectID)
+	assert.Equal(t, "first-user", config.Username)
+	assert.Equal(t, "first-password", config.Password)
+}
+
+func TestGetConfigFromProfileApplicationCredential(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"config_file": testCloudsFile,
+		"profile":     "second",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.Equal(t, "ru-1", config.AuthRegion)
+	assert.Equal(t, "ru-3", config.Region)
+	assert.Equal(t, "222222", config.DomainName)
+	assert.Equal(t, "second-credential", config.ApplicationCredentialID)
+	assert.Equal(t, "second-secret", config.ApplicationCredentialSecret)
+	assert.Empty(t, config.Password)
+}
+
+func TestGetConfigExplicitFieldsOverrideProfile(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"config_file": testCloudsFile,
+		"profile":     "first",
+		"auth_region": "ru-7",
+		"region":      "ru-9",
+		"project_id":  "explicit-project",
+		"auth_token":  "explicit-token",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.Equal(t, "ru-7", config.AuthRegion)
+	assert.Equal(t, "ru-9", config.Region)
+	assert.Equal(t, "explicit-project", config.ProjectID)
+	assert.Equal(t, "111111", config.DomainName)
+
+	// Explicit credentials replace the ones from the profile entirely.
+	assert.Equal(t, "explicit-token", config.AuthToken)
+	assert.Empty(t, config.Password)
+}
@@ -0,0 +1,327 @@
+package selectel
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httpclient"
+)
+
+func testProviderResourceData(t *testing.T, raw map[string]any) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, Provider("test").Schema, raw)
+}
+
+func TestGetConfigSeparateInstances(t *testing.T) {
+	first, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-1",
+		"domain_name": "111111",
+		"username":    "first-user",
+		"password":    "first-password",
+		"project_id":  "first-project",
+		"region":      "ru-1",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	second, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-3",
+		"domain_name": "222222",
+		"username":    "second-user",
+		"password":    "second-password",
+		"project_id":  "second-project",
+		"region":      "ru-3",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.NotSame(t, first, second)
+
+	assert.Equal(t, "111111", first.DomainName)
+	assert.Equal(t, "first-user", first.Username)
+	assert.Equal(t, "first-password", first.Password)
+	assert.Equal(t, "first-project", first.ProjectID)
+	assert.Equal(t, "ru-1", first.Region)
+	assert.Equal(t, "ru-1", first.AuthRegion)
+
+	assert.Equal(t, "222222", second.DomainName)
+	assert.Equal(t, "second-user", second.Username)
+	assert.Equal(t, "second-password", second.Password)
+	assert.Equal(t, "second-project", second.ProjectID)
+	assert.Equal(t, "ru-3", second.Region)
+	assert.Equal(t, "ru-3", second.AuthRegion)
+}
+
+func TestGetConfigClientsCacheNotShared(t *testing.T) {
+	first, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-1",
+		"domain_name": "111111",
+		"username":    "first-user",
+		"password":    "first-password",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	second, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-3",
+		"domain_name": "222222",
+		"username":    "second-user",
+		"password":    "second-password",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	// Without auth_url building a client for the second instance fails before
+	// any request is made unless the first instance's cache leaks.
+	second.AuthURL = ""
+
+	cached := &selvpcclient.Client{}
+	first.clientsCache["client_"] = cached
+
+	client, err := first.GetSelVPCClient()
+	require.NoError(t, err)
+	assert.Same(t, cached, client)
+
+	assert.Empty(t, second.clientsCache)
+
+	client, err = second.GetSelVPCClient()
+	assert.Error(t, err)
+	assert.Nil(t, client)
+	assert.Empty(t, second.clientsCache)
+}
+
+func TestConfigValidateAuth(t *testing.T) {
+	tests := map[string]struct {
+		config  Config
+		wantErr string
+	}{
+		"Password": {
+			config: Config{Username: "user", Password: "secret", DomainName: "123456"},
+		},
+		"Password without username": {
+			config:  Config{Password: "secret", DomainName: "123456"},
+			wantErr: "username must be set",
+		},
+		"Password without domain name": {
+			config:  Config{Username: "user", Password: "secret"},
+			wantErr: "domain_name must be set",
+		},
+		"Token": {
+			config: Config{AuthToken: "token", DomainName: "123456"},
+		},
+		"Token without domain name": {
+			config:  Config{AuthToken: "token"},
+			wantErr: "domain_name must be set",
+		},
+		"Application credential": {
+			config: Config{ApplicationCredentialID: "id", ApplicationCredentialSecret: "secret"},
+		},
+		"Application credential without secret": {
+			config:  Config{ApplicationCredentialID: "id"},
+			wantErr: "must be set together",
+		},
+		"No credentials": {
+			config:  Config{Username: "user", DomainName: "123456"},
+			wantErr: "one of password, auth_token or application_credential_id must be set",
+		},
+		"Password and token": {
+			config:  Config{Username: "user", Password: "secret", AuthToken: "token", DomainName: "123456"},
+			wantErr: "only one authentication method can be used, got: password, auth_token",
+		},
+		"Token and application credential": {
+			config:  Config{AuthToken: "token", ApplicationCredentialID: "id", ApplicationCredentialSecret: "secret"},
+			wantErr: "only one authentication method can be used, got: auth_token, application_credential_id",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.validateAuth()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestConfigAuthOptions(t *testing.T) {
+	tokenConfig := &Config{
+		AuthURL:    "https://cloud.api.selcloud.ru/identity/v3/",
+		AuthToken:  "token",
+		DomainName: "123456",
+	}
+
+	opts := tokenConfig.authOptions("")
+	assert.Equal(t, "token", opts.TokenID)
+	assert.Equal(t, &gophercloud.AuthScope{DomainName: "123456"}, opts.Scope)
+
+	opts = tokenConfig.authOptions("project")
+	assert.Equal(t, &gophercloud.AuthScope{ProjectID: "project"}, opts.Scope)
+
+	appCredentialConfig := &Config{
+		AuthURL:                     "https://cloud.api.selcloud.ru/identity/v3/",
+		ApplicationCredentialID:     "id",
+		ApplicationCredentialSecret: "secret",
+	}
+
+	opts = appCredentialConfig.authOptions("project")
+	assert.Equal(t, "id", opts.ApplicationCredentialID)
+	assert.Equal(t, "secret", opts.ApplicationCredentialSecret)
+	assert.Empty(t, opts.TokenID)
+	assert.Nil(t, opts.Scope)
+}
+
+type testApplicationCredentialAuthResult struct {
+	project *tokens.Project
+	err     error
+}
+
+func (r testApplicationCredentialAuthResult) ExtractTokenID() (string, error) {
+	return "token", nil
+}
+
+func (r testApplicationCredentialAuthResult) ExtractProject() (*tokens.Project, error) {
+	return r.project, r.err
+}
+
+func TestCheckApplicationCredentialScope(t *testing.T) {
+	result := testApplicationCredentialAuthResult{project: &tokens.Project{ID: "project"}}
+
+	assert.NoError(t, checkApplicationCredentialScope(result, "project"))
+	assert.EqualError(t, checkApplicationCredentialScope(result, "other-project"),
+		"application credential is bound to project project and can't be used for project other-project")
+	assert.ErrorContains(t, checkApplicationCredentialScope(result, ""), "can't be used for account-scoped requests")
+
+	assert.Error(t, checkApplicationCredentialScope(testApplicationCredentialAuthResult{}, "project"))
+	assert.Error(t, checkApplicationCredentialScope(
+		testApplicationCredentialAuthResult{err: errors.New("unexpected token")}, "project",
+	))
+}
+
+func TestGetConfigAuthMethodConflict(t *testing.T) {
+	_, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-1",
+		"domain_name": "123456",
+		"username":    "user",
+		"password":    "secret",
+		"auth_token":  "token",
+	}), "test")
+
+	require.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Summary, "only one authentication method can be used")
+}
+
+func TestConfigGetXAuthToken(t *testing.T) {
+	providerClient := &gophercloud.ProviderClient{}
+	providerClient.SetToken("identity-token")
+
+	client := &selvpcclient.Client{}
+	config := &Config{
+		identityClients: map[*selvpcclient.Client]*gophercloud.ServiceClient{
+			client: {ProviderClient: providerClient},
+		},
+	}
+
+	assert.Equal(t, "identity-token", config.GetXAuthToken(client))
+}
+
+func TestGetConfigHTTPSettings(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":        "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region":     "ru-1",
+		"domain_name":     "123456",
+		"username":        "user",
+		"password":        "secret",
+		"max_retries":     10,
+		"retry_wait_min":  "2s",
+		"retry_wait_max":  "1m",
+		"request_timeout": "5m",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.Equal(t, 10, config.MaxRetries)
+	assert.Equal(t, 2*time.Second, config.RetryWaitMin)
+	assert.Equal(t, time.Minute, config.RetryWaitMax)
+	assert.Equal(t, 5*time.Minute, config.RequestTimeout)
+
+	httpClient := config.GetHTTPClient(DBaaS)
+	assert.Same(t, httpClient, config.GetHTTPClient(DBaaS))
+	assert.Same(t, httpClient, config.GetHTTPClient(MKS))
+	assert.Same(t, httpClient, config.GetHTTPClient(GlobalRouter))
+	assert.NotSame(t, http.DefaultClient, httpClient)
+
+	domainsClient := config.GetHTTPClient(DomainsV1)
+	assert.NotSame(t, httpClient, domainsClient, "domains v1 retries all server errors")
+	assert.Same(t, domainsClient, config.GetHTTPClient(PrivateDNS))
+	assert.Same(t, domainsClient, config.GetHTTPClient(PublicNetAPI))
+}
+
+func TestGetConfigHTTPLogging(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":            "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region":         "ru-1",
+		"domain_name":         "123456",
+		"username":            "user",
+		"password":            "secret",
+		"enable_http_logging": true,
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.True(t, config.HTTPLogging)
+
+	dbaasClient := config.GetHTTPClient(DBaaS)
+	assert.Same(t, dbaasClient, config.GetHTTPClient(DBaaS))
+	assert.NotSame(t, dbaasClient, config.GetHTTPClient(MKS))
+}
+
+func TestHTTPLoggingSubsystem(t *testing.T) {
+	assert.Equal(t, "dbaas", httpLoggingSubsystem(DBaaS))
+	assert.Equal(t, "dedicated", httpLoggingSubsystem(DedicatedServers))
+	assert.Equal(t, "secrets_manager", httpLoggingSubsystem(SecretsManager))
+	assert.Equal(t, "global_router", httpLoggingSubsystem(GlobalRouter))
+	assert.Equal(t, "unknown", httpLoggingSubsystem("unknown"))
+}
+
+func TestGetConfigHTTPSettingsDefaults(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-1",
+		"domain_name": "123456",
+		"username":    "user",
+		"password":    "secret",
+	}), "test")
+	require.Nil(t, diagErr)
+
+	assert.Equal(t, httpclient.DefaultMaxRetries, config.MaxRetries)
+	assert.Equal(t, httpclient.DefaultRetryWaitMin, config.RetryWaitMin)
+	assert.Equal(t, httpclient.DefaultRetryWaitMax, config.RetryWaitMax)
+	assert.Equal(t, httpclient.DefaultRequestTimeout, config.RequestTimeout)
+}
+
+func TestGetConfigInvalidRetryWait(t *testing.T) {
+	_, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":       "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region":    "ru-1",
+		"domain_name":    "123456",
+		"username":       "user",
+		"password":       "secret",
+		"retry_wait_min": "10s",
+		"retry_wait_max": "1s",
+	}), "test")
+
+	require.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Summary, "retry_wait_min must not be greater than retry_wait_max")
+}
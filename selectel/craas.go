@@ -71,12 +71,15 @@ func getCRaaSClient(d *schema.ResourceData, meta any) (*clientv1.ServiceClient,
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for craas: %w", err))
 	}
 
-	endpoint, diagErr := getEndpointForCRaaS(selvpcClient, CRaaS)
-	if diagErr != nil {
-		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init craas client: %w", err))
+	endpoint, ok := config.getEndpointOverride(CRaaS)
+	if !ok {
+		endpoint, err = getEndpointForCRaaS(selvpcClient, CRaaS)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init craas client: %w", err))
+		}
 	}
 
-	craasClient := v1.NewCRaaSClientV1(selvpcClient.GetXAuthToken(), endpoint)
+	craasClient := v1.NewCRaaSClientV1WithCustomHTTP(config.GetHTTPClient(CRaaS), config.GetXAuthToken(selvpcClient), endpoint)
 
 	return craasClient, nil
 }
@@ -88,12 +91,15 @@ func getCRaaSClientV2(d *schema.ResourceData, meta any) (*clientv2.ServiceClient
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for craas v2: %w", err))
 	}
 
-	endpoint, diagErr := getEndpointForCRaaS(selvpcClient, CRaaSV2)
-	if diagErr != nil {
-		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init craas client v2: %w", err))
+	endpoint, ok := config.getEndpointOverride(CRaaSV2)
+	if !ok {
+		endpoint, err = getEndpointForCRaaS(selvpcClient, CRaaSV2)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init craas client v2: %w", err))
+		}
 	}
 
-	craasClient, err := clientv2.NewCRaaSClientV2(selvpcClient.GetXAuthToken(), endpoint)
+	craasClient, err := clientv2.NewCRaaSClientV2WithCustomHTTP(config.GetHTTPClient(CRaaSV2), config.GetXAuthToken(selvpcClient), endpoint)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't create client craas v2: %w", err))
 	}
@@ -31,7 +31,7 @@ func newCRaaSTestClient(rs *terraform.ResourceState, testAccProvider *schema.Pro
 		return nil, fmt.Errorf("can't get endpoint for craas acc tests: %w", err)
 	}
 
-	craasClient := v1.NewCRaaSClientV1(selvpcClient.GetXAuthToken(), craasEndpoint)
+	craasClient := v1.NewCRaaSClientV1(config.GetXAuthToken(selvpcClient), craasEndpoint)
 
 	return craasClient, nil
 }
@@ -55,7 +55,7 @@ func newCRaaSV2TestClient(rs *terraform.ResourceState, testAccProvider *schema.P
 		return nil, fmt.Errorf("can't get endpoint for craas v2 acc tests: %w", err)
 	}
 
-	craasClient, err := clientv2.NewCRaaSClientV2(selvpcClient.GetXAuthToken(), craasEndpoint)
+	craasClient, err := clientv2.NewCRaaSClientV2(config.GetXAuthToken(selvpcClient), craasEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("can't create craas v2 client for acc tests: %w", err)
 	}
@@ -0,0 +1,85 @@
+package selectel
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDBaaSBackupsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSBackupsV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"allow_restore": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"backup_retention_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"restore_windows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Estimated periods within which the datastore state can be restored, calculated from the backup retention days instead of the actual backups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSBackupsV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	datastoreID := d.Get("datastore_id").(string)
+
+	datastore, err := dbaasClient.Datastore(ctx, datastoreID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDatastore, datastoreID, err))
+	}
+
+	restoreWindows := make([]any, 0, 1)
+	if start, end, ok := dbaasDatastoreV1RestoreWindow(datastore, time.Now()); ok {
+		restoreWindows = append(restoreWindows, map[string]any{
+			"start_time": start.Format(time.RFC3339),
+			"end_time":   end.Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(datastoreID)
+	d.Set("allow_restore", datastore.AllowRestore)
+	d.Set("backup_retention_days", datastore.BackupRetentionDays)
+	if err := d.Set("restore_windows", restoreWindows); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
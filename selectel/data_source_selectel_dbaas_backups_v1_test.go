@@ -0,0 +1,98 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/dbaas-go"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDBaaSDataSourceBackupsV1Basic(t *testing.T) {
+	var (
+		dbaasDatastore dbaas.Datastore
+		project        projects.Project
+	)
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	nodeCount := 1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDataSourceBackupsV1Basic(projectName, datastoreName, nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					testAccCheckDBaaSDatastoreV1Exists("selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1", &dbaasDatastore),
+					resource.TestCheckResourceAttrPair(
+						"data.selectel_dbaas_backups_v1.backups_tf_acc_test_1", "datastore_id",
+						"selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_backups_v1.backups_tf_acc_test_1", "allow_restore"),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_backups_v1.backups_tf_acc_test_1", "backup_retention_days"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBaaSDataSourceBackupsV1Basic(projectName, datastoreName string, nodeCount int) string {
+	return fmt.Sprintf(`
+%s
+
+data "selectel_dbaas_backups_v1" "backups_tf_acc_test_1" {
+  project_id   = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region       = "ru-3"
+  datastore_id = "${selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1.id}"
+}
+`, testAccDBaaSPostgreSQLDatastoreV1Basic(projectName, datastoreName, nodeCount))
+}
+
+func TestDBaaSDatastoreV1RestoreWindow(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	_, _, ok := dbaasDatastoreV1RestoreWindow(dbaas.Datastore{AllowRestore: false}, now)
+	assert.False(t, ok)
+
+	start, end, ok := dbaasDatastoreV1RestoreWindow(dbaas.Datastore{
+		AllowRestore:        true,
+		BackupRetentionDays: 14,
+		CreationFinishedAt:  "2026-01-01T00:00:00Z",
+	}, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 4, 12, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, now, end)
+
+	start, _, ok = dbaasDatastoreV1RestoreWindow(dbaas.Datastore{
+		AllowRestore:       true,
+		CreationFinishedAt: "2026-10-15T08:30:00",
+	}, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 15, 8, 30, 0, 0, time.UTC), start, "window starts at the datastore creation")
+
+	start, _, ok = dbaasDatastoreV1RestoreWindow(dbaas.Datastore{AllowRestore: true}, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 11, 12, 0, 0, 0, time.UTC), start, "default retention period is used")
+}
+
+func TestParseDBaaSRestoreTime(t *testing.T) {
+	expected := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"2026-10-18T12:00:00+03:00", "2026-10-18T09:00:00Z", "2026-10-18T09:00:00", "2026-10-18 09:00:00"} {
+		parsed, err := parseDBaaSRestoreTime(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, parsed, value)
+	}
+
+	_, err := parseDBaaSRestoreTime("yesterday")
+	assert.Error(t, err)
+}
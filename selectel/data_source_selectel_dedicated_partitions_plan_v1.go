@@ -0,0 +1,346 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	dedicated "github.com/selectel/dedicated-go/v2/pkg/v2"
+)
+
+func dataSourceDedicatedPartitionsPlanV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDedicatedPartitionsPlanV1Read,
+		Schema: map[string]*schema.Schema{
+			dedicatedServerSchemaKeyProjectID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			dedicatedServerSchemaKeyConfigurationID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			dedicatedServerSchemaKeyLocationID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			dedicatedServerSchemaKeyOSID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			dedicatedServerSchemaKeyOSPartitionsConfig: resourceDedicatedServerV1Schema()[dedicatedServerSchemaKeyOSPartitionsConfig],
+			// computed
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"validation_errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"local_drives": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"partitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dedicatedServerSchemaKeyMount: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						dedicatedServerSchemaKeyFSType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						dedicatedServerSchemaKeySize: {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						dedicatedServerSchemaKeyLevel: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_drive_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"resolved_partitions_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dedicatedServerSchemaKeySoftRaidConfig: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									dedicatedServerSchemaKeyName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyLevel: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyDiskType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyDiskCount: {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						dedicatedServerSchemaKeyDiskPartitions: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									dedicatedServerSchemaKeyDiskName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyMount: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeySize: {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeySizePercent: {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyRaid: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyFSType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						dedicatedServerSchemaKeyDiskConfig: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									dedicatedServerSchemaKeyName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									dedicatedServerSchemaKeyDiskType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedPartitionsPlanV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	dsClient, diagErr := getDedicatedClient(d, meta, true)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	var (
+		configurationID = d.Get(dedicatedServerSchemaKeyConfigurationID).(string)
+		locationID      = d.Get(dedicatedServerSchemaKeyLocationID).(string)
+		osID            = d.Get(dedicatedServerSchemaKeyOSID).(string)
+	)
+
+	log.Printf("[DEBUG] Getting %s for %s %s in %s %s", objectOS, objectDedicatedServer, configurationID, objectLocation, locationID)
+
+	operatingSystems, _, err := dsClient.OperatingSystems(ctx, &dedicated.OperatingSystemsQuery{
+		LocationID: locationID,
+		ServiceID:  configurationID,
+	})
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectOS, err))
+	}
+
+	os := operatingSystems.FindOneByID(osID)
+	if os == nil {
+		return diag.FromErr(fmt.Errorf(
+			"%s %s is not available for %s %s in %s %s",
+			objectOS, osID, objectDedicatedServer, configurationID, objectLocation, locationID,
+		))
+	}
+
+	localDrives, _, err := dsClient.LocalDrives(ctx, configurationID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"error getting local drives for %s %s: %w", objectDedicatedServer, configurationID, err,
+		))
+	}
+
+	var (
+		apiCfg           dedicated.PartitionsConfig
+		validationErrors []string
+	)
+
+	partitionsConfig, err := resourceDedicatedServerV1ReadPartitionsConfig(d)
+	switch {
+	case err != nil:
+		validationErrors = append(validationErrors, fmt.Sprintf("failed to read partitions config: %s", err))
+
+	case !os.Partitioning:
+		if !partitionsConfig.IsEmpty() {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s %s does not support partitions config", objectOS, os.OSValue))
+		}
+
+	default:
+		apiCfg, err = partitionsConfig.CastToAPIPartitionsConfig(localDrives, os.DefaultPartitions)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("failed to read partitions config input: %s", err))
+
+			break
+		}
+
+		_, _, err = dsClient.PartitionsValidate(ctx, apiCfg, configurationID)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("failed to validate partitions config: %s", err))
+		}
+	}
+
+	// All mounts of the plan are returned, including the ones added by default,
+	// so existing mounts aren't passed here.
+	resolvedPartitionsConfig, err := apiPartitionsConfigToSchema(
+		apiCfg,
+		resourceDedicatedServerV1ReadExistingDiskNames(d),
+		nil,
+		resourceDedicatedServerV1ReadExistingDiskNameByMount(d),
+		resourceDedicatedServerV1ReadExistingDiskConfigOrder(d),
+		resourceDedicatedServerV1ReadExistingRaidNames(d),
+		resourceDedicatedServerV1ReadExistingRaidConfigOrder(d),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{configurationID, locationID, osID}, "/"))
+	d.Set("valid", len(validationErrors) == 0)
+	d.Set("validation_errors", validationErrors)
+	d.Set("local_drives", flattenDedicatedLocalDrives(localDrives))
+	d.Set("partitions", flattenDedicatedPartitionsPlanV1Partitions(apiCfg))
+	d.Set("resolved_partitions_config", resolvedPartitionsConfig)
+
+	return nil
+}
+
+// flattenDedicatedPartitionsPlanV1Partitions returns the filesystems of the
+// API partitions config together with the local drives they are placed on.
+func flattenDedicatedPartitionsPlanV1Partitions(apiCfg dedicated.PartitionsConfig) []any {
+	partitions := make([]map[string]any, 0)
+
+	for _, item := range apiCfg {
+		if item.Type != partitionTypeFilesystem {
+			continue
+		}
+
+		var (
+			size       float64
+			level      string
+			driveIDs   []string
+			device, ok = apiCfg[item.Device]
+		)
+
+		switch {
+		case !ok:
+			driveIDs = append(driveIDs, item.Device)
+
+		case device.Type == partitionTypeSoftRaid:
+			level = device.Level
+			for _, memberID := range device.Members {
+				member, ok := apiCfg[memberID]
+				if !ok {
+					continue
+				}
+
+				size = member.Size
+				driveIDs = append(driveIDs, member.Device)
+			}
+
+		case device.Type == partitionTypePartition:
+			size = device.Size
+			driveIDs = append(driveIDs, device.Device)
+
+		default:
+			driveIDs = append(driveIDs, item.Device)
+		}
+
+		slices.Sort(driveIDs)
+
+		partitions = append(partitions, map[string]any{
+			dedicatedServerSchemaKeyMount:  item.Mount,
+			dedicatedServerSchemaKeyFSType: item.FSType,
+			dedicatedServerSchemaKeySize:   size,
+			dedicatedServerSchemaKeyLevel:  level,
+			"local_drive_ids":              driveIDs,
+		})
+	}
+
+	slices.SortFunc(partitions, func(a, b map[string]any) int {
+		mountA, _ := a[dedicatedServerSchemaKeyMount].(string)
+		mountB, _ := b[dedicatedServerSchemaKeyMount].(string)
+		if prioA, prioB := mountSortPriority(mountA), mountSortPriority(mountB); prioA != prioB {
+			return prioA - prioB
+		}
+
+		return strings.Compare(mountA, mountB)
+	})
+
+	res := make([]any, len(partitions))
+	for i, partition := range partitions {
+		res[i] = partition
+	}
+
+	return res
+}
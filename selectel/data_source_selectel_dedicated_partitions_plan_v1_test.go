@@ -0,0 +1,157 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	dedicated "github.com/selectel/dedicated-go/v2/pkg/v2"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDedicatedPartitionsPlanV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedPartitionsPlanV1Basic(projectName, "Ubuntu", "2404", "SPB-5", "EL10-SSD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "valid", "true"),
+					resource.TestCheckResourceAttr("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "validation_errors.#", "0"),
+					resource.TestCheckResourceAttr("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "partitions.0.mount", "/boot"),
+					resource.TestCheckResourceAttr("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "partitions.0.level", "raid1"),
+					resource.TestCheckResourceAttr("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "partitions.0.local_drive_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("data.selectel_dedicated_partitions_plan_v1.plan_tf_acc_test_1", "local_drives.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDedicatedPartitionsPlanV1Basic(projectName, osName, osVersion, locationName, cfgName string) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name = "%s"
+}
+
+data "selectel_dedicated_os_v1" "os_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+
+  filter {
+    name          = "%s"
+    version_value = "%s"
+  }
+}
+
+data "selectel_dedicated_location_v1" "location_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+
+  filter {
+    name = "%s"
+  }
+}
+
+data "selectel_dedicated_configuration_v1" "server_configuration_tf_acc_test_1" {
+  project_id  = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  deep_filter = "{\"name\": \"%s\"}"
+}
+
+data "selectel_dedicated_partitions_plan_v1" "plan_tf_acc_test_1" {
+  project_id       = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  configuration_id = "${data.selectel_dedicated_configuration_v1.server_configuration_tf_acc_test_1.configurations.0.id}"
+  location_id      = "${data.selectel_dedicated_location_v1.location_tf_acc_test_1.locations[0].id}"
+  os_id            = "${data.selectel_dedicated_os_v1.os_tf_acc_test_1.os.0.id}"
+
+  partitions_config {
+    soft_raid_config {
+      name      = "first-raid"
+      level     = "raid1"
+      disk_type = "SSD"
+    }
+
+    disk_partitions {
+      mount = "/boot"
+      size  = 1
+      raid  = "first-raid"
+    }
+
+    disk_partitions {
+      mount = "/"
+      size  = -1
+      raid  = "first-raid"
+    }
+  }
+}
+`, projectName, osName, osVersion, locationName, cfgName)
+}
+
+func TestFlattenDedicatedPartitionsPlanV1Partitions(t *testing.T) {
+	localDrives := dedicated.LocalDrives{
+		"drive-ssd-1": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 1000, Type: "SSD"}},
+		"drive-ssd-2": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 1000, Type: "SSD"}},
+		"drive-hdd-1": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 2000, Type: "HDD"}},
+	}
+	defaultPartitions := []*dedicated.PartitionConfigItem{
+		{Mount: "/boot", Size: 1, FSType: "ext3"},
+		{Mount: "/", Size: -1, FSType: "ext4"},
+	}
+
+	pc := &PartitionsConfig{
+		SoftRaidConfig: []*SoftRaidConfigItem{
+			{Name: "system", Level: "raid1", DiskType: "SSD", Count: 2},
+		},
+		DiskPartitions: []*DiskPartitionsItem{
+			{Mount: "/", Size: -1, Raid: "system"},
+			{Mount: "/data", Size: -1, DiskName: "data", FSType: "xfs"},
+		},
+		DiskConfig: []*DiskConfigItem{
+			{Name: "data", DiskType: "HDD"},
+		},
+	}
+
+	apiCfg, err := pc.CastToAPIPartitionsConfig(localDrives, defaultPartitions)
+	require.NoError(t, err)
+
+	partitions := flattenDedicatedPartitionsPlanV1Partitions(apiCfg)
+	require.Len(t, partitions, 3)
+
+	expected := []map[string]any{
+		{
+			"mount":           "/boot",
+			"fs_type":         "ext3",
+			"size":            1.0,
+			"level":           "raid1",
+			"local_drive_ids": []string{"drive-ssd-1", "drive-ssd-2"},
+		},
+		{
+			"mount":           "/",
+			"fs_type":         "ext4",
+			"size":            -1.0,
+			"level":           "raid1",
+			"local_drive_ids": []string{"drive-ssd-1", "drive-ssd-2"},
+		},
+		{
+			"mount":           "/data",
+			"fs_type":         "xfs",
+			"size":            -1.0,
+			"level":           "",
+			"local_drive_ids": []string{"drive-hdd-1"},
+		},
+	}
+	for i, partition := range partitions {
+		assert.Equal(t, expected[i], partition)
+	}
+}
+
+func TestFlattenDedicatedPartitionsPlanV1PartitionsEmpty(t *testing.T) {
+	assert.Empty(t, flattenDedicatedPartitionsPlanV1Partitions(nil))
+}
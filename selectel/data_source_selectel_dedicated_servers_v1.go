@@ -3,6 +3,7 @@ package selectel
 import (
 	"context"
 	"fmt"
+	"log"
 	"slices"
 	"strings"
 
@@ -49,6 +50,10 @@ func dataSourceDedicatedServersV1() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"price_plan": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -96,6 +101,34 @@ func dataSourceDedicatedServersV1() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"price_plan": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_drives": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"size": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -148,12 +181,31 @@ func dataSourceDedicatedServersV1Read(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("error getting reserved private IPs: %w", err))
 	}
 
+	pricePlans, _, err := dsClient.PricePlans(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting price plans: %w", err))
+	}
+
+	pricePlanNameByUUID := make(map[string]string, len(pricePlans))
+	for _, pricePlan := range pricePlans {
+		pricePlanNameByUUID[pricePlan.UUID] = pricePlan.Name
+	}
+
 	filteredServers, err := filterDedicatedServers(servers, filter, reservedPublicIPs, reservedPrivateIPs, configNameByUUID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error filtering servers: %w", err))
 	}
 
-	serversFlatten := flattenDedicatedServers(filteredServers, reservedPublicIPs, reservedPrivateIPs, configNameByUUID, locationNameByUUID)
+	filteredServers = filterDedicatedServersByPricePlan(filteredServers, filter.pricePlan, pricePlanNameByUUID)
+
+	detailsByUUID, err := getDedicatedServersV1Details(ctx, dsClient, filteredServers, pricePlanNameByUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serversFlatten := flattenDedicatedServers(
+		filteredServers, reservedPublicIPs, reservedPrivateIPs, configNameByUUID, locationNameByUUID, detailsByUUID,
+	)
 	if err = d.Set("servers", serversFlatten); err != nil {
 		return diag.FromErr(err)
 	}
@@ -182,10 +234,12 @@ type dedicatedServersSearchFilter struct {
 	configuration string
 	publicSubnet  string
 	privateSubnet string
+	pricePlan     string
 }
 
 // IsEmpty reports whether all in-memory filter fields are unset.
 // locationID is excluded because it is applied at the API call layer, not in-memory.
+// pricePlan is excluded because it is applied by filterDedicatedServersByPricePlan.
 func (f dedicatedServersSearchFilter) IsEmpty() bool {
 	return f.name == "" &&
 		f.ip == "" &&
@@ -222,6 +276,9 @@ func expandDedicatedServersSearchFilter(d *schema.ResourceData) dedicatedServers
 	if v, ok := m["private_subnet"]; ok {
 		filter.privateSubnet = v.(string)
 	}
+	if v, ok := m["price_plan"]; ok {
+		filter.pricePlan = v.(string)
+	}
 
 	return filter
 }
@@ -308,10 +365,74 @@ func serverIPMatchesFilter(
 	return false
 }
 
+// filterDedicatedServersByPricePlan returns servers with the current price
+// plan of the given name. The name is compared case-insensitively.
+func filterDedicatedServersByPricePlan(
+	servers []dedicated.ResourceDetails, pricePlan string, pricePlanNameByUUID map[string]string,
+) []dedicated.ResourceDetails {
+	if pricePlan == "" {
+		return servers
+	}
+
+	filteredServers := make([]dedicated.ResourceDetails, 0, len(servers))
+	for _, server := range servers {
+		if strings.EqualFold(pricePlanNameByUUID[server.Billing.CurrentPricePlan.UUID], pricePlan) {
+			filteredServers = append(filteredServers, server)
+		}
+	}
+
+	return filteredServers
+}
+
+// dedicatedServerV1Details contains server details that aren't returned in
+// the servers list and are requested separately.
+type dedicatedServerV1Details struct {
+	pricePlan   string
+	powerState  string
+	localDrives dedicated.LocalDrives
+}
+
+func getDedicatedServersV1Details(
+	ctx context.Context, dsClient *dedicated.ServiceClient,
+	servers []dedicated.ResourceDetails, pricePlanNameByUUID map[string]string,
+) (map[string]dedicatedServerV1Details, error) {
+	var (
+		detailsByUUID       = make(map[string]dedicatedServerV1Details, len(servers))
+		localDrivesByConfig = make(map[string]dedicated.LocalDrives)
+	)
+
+	for _, server := range servers {
+		powerState, err := getDedicatedServerV1PowerState(ctx, dsClient, server.UUID)
+		if err != nil {
+			log.Printf("[WARN] unable to get power state of %s %s: %s", objectDedicatedServer, server.UUID, err)
+		}
+
+		localDrives, ok := localDrivesByConfig[server.ServiceUUID]
+		if !ok {
+			localDrives, _, err = dsClient.LocalDrives(ctx, server.ServiceUUID)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error getting local drives for %s %s: %w", objectDedicatedServer, server.ServiceUUID, err,
+				)
+			}
+			localDrivesByConfig[server.ServiceUUID] = localDrives
+		}
+
+		detailsByUUID[server.UUID] = dedicatedServerV1Details{
+			pricePlan:   pricePlanNameByUUID[server.Billing.CurrentPricePlan.UUID],
+			powerState:  powerState,
+			localDrives: localDrives,
+		}
+	}
+
+	return detailsByUUID, nil
+}
+
 func flattenDedicatedServers(
 	servers []dedicated.ResourceDetails,
 	reservedPublicIPs, reservedPrivateIPs dedicated.ReservedIPs,
 	configNameByUUID, locationNameByUUID map[string]string,
+	detailsByUUID map[string]dedicatedServerV1Details,
 ) []any {
 	serversList := make([]any, len(servers))
 
@@ -347,6 +468,11 @@ func flattenDedicatedServers(
 		serverMap["reserved_public_ips"] = publicIPs
 		serverMap["reserved_private_ips"] = privateIPs
 
+		details := detailsByUUID[server.UUID]
+		serverMap["price_plan"] = details.pricePlan
+		serverMap["power_state"] = details.powerState
+		serverMap["local_drives"] = flattenDedicatedLocalDrives(details.localDrives)
+
 		serversList[i] = serverMap
 	}
 
@@ -370,6 +370,7 @@ func TestExpandDedicatedServersSearchFilter(t *testing.T) {
 		"configuration":  "EL50",
 		"public_subnet":  "public-subnet-1",
 		"private_subnet": "private-subnet-1",
+		"price_plan":     "1 month",
 	})
 	_ = d.Set("filter", filterSet)
 
@@ -381,6 +382,7 @@ func TestExpandDedicatedServersSearchFilter(t *testing.T) {
 	assert.Equal(t, "EL50", filter.configuration)
 	assert.Equal(t, "public-subnet-1", filter.publicSubnet)
 	assert.Equal(t, "private-subnet-1", filter.privateSubnet)
+	assert.Equal(t, "1 month", filter.pricePlan)
 }
 
 func TestExpandDedicatedServersSearchFilter_Empty(t *testing.T) {
@@ -433,7 +435,17 @@ func TestFlattenDedicatedServers(t *testing.T) {
 		"location-uuid-2": "Saint Petersburg",
 	}
 
-	result := flattenDedicatedServers(servers, reservedPublicIPs, reservedPrivateIPs, configNames, locationNames)
+	details := map[string]dedicatedServerV1Details{
+		"server-uuid-1": {
+			pricePlan:  "1 month",
+			powerState: "on",
+			localDrives: dedicated.LocalDrives{
+				"drive-1": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 480, Type: "SSD"}},
+			},
+		},
+	}
+
+	result := flattenDedicatedServers(servers, reservedPublicIPs, reservedPrivateIPs, configNames, locationNames, details)
 
 	assert.Len(t, result, 2)
 
@@ -448,6 +460,9 @@ func TestFlattenDedicatedServers(t *testing.T) {
 	assert.Contains(t, publicIPs1, "192.168.1.10")
 	privateIPs1 := server1["reserved_private_ips"].([]string)
 	assert.Contains(t, privateIPs1, "10.0.0.5")
+	assert.Equal(t, "1 month", server1["price_plan"])
+	assert.Equal(t, "on", server1["power_state"])
+	assert.Equal(t, []any{map[string]any{"id": "drive-1", "type": "SSD", "size": 480}}, server1["local_drives"])
 
 	server2 := result[1].(map[string]any)
 	assert.Equal(t, "server-uuid-2", server2["id"])
@@ -460,6 +475,8 @@ func TestFlattenDedicatedServers(t *testing.T) {
 	assert.Contains(t, publicIPs2, "192.168.1.11")
 	privateIPs2 := server2["reserved_private_ips"].([]string)
 	assert.Empty(t, privateIPs2, "should have no private IPs")
+	assert.Equal(t, "", server2["price_plan"], "empty when details are not found")
+	assert.Empty(t, server2["local_drives"])
 }
 
 func TestFlattenDedicatedServers_NoIPs(t *testing.T) {
@@ -473,7 +490,7 @@ func TestFlattenDedicatedServers_NoIPs(t *testing.T) {
 		},
 	}
 
-	result := flattenDedicatedServers(servers, nil, nil, map[string]string{}, map[string]string{})
+	result := flattenDedicatedServers(servers, nil, nil, map[string]string{}, map[string]string{}, nil)
 
 	assert.Len(t, result, 1)
 	server1 := result[0].(map[string]any)
@@ -583,7 +600,7 @@ func TestFlattenDedicatedServers_UsesCustomName(t *testing.T) {
 	configNames := map[string]string{"cfg-1": "EL50 SSD"}
 	locationNames := map[string]string{"loc-1": "Moscow"}
 
-	result := flattenDedicatedServers(servers, publicIPs, privateIPs, configNames, locationNames)
+	result := flattenDedicatedServers(servers, publicIPs, privateIPs, configNames, locationNames, nil)
 	require.Len(t, result, 2)
 
 	s1 := result[0].(map[string]any)
@@ -615,3 +632,19 @@ func TestBuildConfigNameByUUID(t *testing.T) {
 	assert.Equal(t, "EL10 Chip", m["uuid-3"])
 	assert.Len(t, m, 3)
 }
+
+func TestFilterDedicatedServersByPricePlan(t *testing.T) {
+	servers := make([]dedicated.ResourceDetails, 3)
+	for i, planUUID := range []string{"plan-day", "plan-month", "plan-month"} {
+		servers[i].UUID = fmt.Sprintf("s-%d", i+1)
+		servers[i].Billing.CurrentPricePlan.UUID = planUUID
+	}
+	pricePlanNameByUUID := map[string]string{
+		"plan-day":   "1 day",
+		"plan-month": "1 month",
+	}
+
+	assert.Len(t, filterDedicatedServersByPricePlan(servers, "", pricePlanNameByUUID), 3)
+	assert.Len(t, filterDedicatedServersByPricePlan(servers, "1 MONTH", pricePlanNameByUUID), 2)
+	assert.Len(t, filterDedicatedServersByPricePlan(servers, "1 year", pricePlanNameByUUID), 0)
+}
@@ -0,0 +1,140 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/domains-go/pkg/v1/record"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+func dataSourceDomainsRRSetMappingV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRRSetMappingV2Read,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rrset_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRRSetMappingV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	domainID := d.Get("domain_id").(int)
+
+	zoneName, err := getDomainsV1DomainName(ctx, meta, domainID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	v1Client, err := getDomainsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectRecord, strconv.Itoa(domainID)))
+
+	records, _, err := record.ListByDomainID(ctx, v1Client, domainID)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectRecord, err))
+	}
+
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, err := getZoneByName(ctx, client, zoneName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets, err := listRRSets(ctx, client, zone.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(domainID))
+	d.Set("zone_id", zone.ID)
+	d.Set("zone_name", zone.Name)
+	if err := d.Set("rrsets", flattenDomainsV1RRSetGroups(domainID, zone.Name, groupDomainsV1Records(zoneName, records), rrsets)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenDomainsV1RRSetGroups maps groups of v1 records to RRSets of the zone.
+// The RRSet ID is empty if the zone has no RRSet with the name and type yet.
+func flattenDomainsV1RRSetGroups(domainID int, zoneName string, groups []domainsV1RRSetGroup, rrsets []*domainsV2.RRSet) []any {
+	rrsetIDByKey := make(map[string]string, len(rrsets))
+	for _, rrset := range rrsets {
+		rrsetIDByKey[zoneFileFQDN(rrset.Name, "")+"/"+string(rrset.Type)] = rrset.ID
+	}
+
+	result := make([]any, 0, len(groups))
+	for _, group := range groups {
+		recordIDs := make([]string, 0, len(group.RecordIDs))
+		for _, recordID := range group.RecordIDs {
+			recordIDs = append(recordIDs, fmt.Sprintf("%d/%d", domainID, recordID))
+		}
+
+		result = append(result, map[string]any{
+			"name":       group.Name,
+			"type":       group.Type,
+			"rrset_id":   rrsetIDByKey[group.Name+"/"+group.Type],
+			"import_id":  fmt.Sprintf("%s/%s/%s", zoneName, group.Name, group.Type),
+			"record_ids": recordIDs,
+		})
+	}
+
+	return result
+}
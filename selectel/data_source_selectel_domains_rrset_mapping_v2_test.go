@@ -0,0 +1,68 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainsRRSetMappingV2DataSourceBasic(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	dataSourceName := "data.selectel_domains_rrset_mapping_v2.mapping_tf_acc_test_1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetMappingV2DataSourceBasic(testProjectName, testDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", fmt.Sprintf("selectel_domains_zone_v2.%[1]s", resourceZoneName), "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.name", "www."+testDomainName+"."),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.record_ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.import_id", fmt.Sprintf("%[1]s./www.%[1]s./A", testDomainName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRRSetMappingV2DataSourceBasic(projectName, domainName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+	  name = %[2]q
+	}
+
+	resource "selectel_domains_record_v1" "record_a_tf_acc_test_1" {
+	  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+	  name = "www.%[2]s"
+	  type = "A"
+	  content = "192.0.2.1"
+	  ttl  = 60
+	}
+
+	resource "selectel_domains_record_v1" "record_a_tf_acc_test_2" {
+	  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+	  name = "www.%[2]s"
+	  type = "A"
+	  content = "192.0.2.2"
+	  ttl  = 60
+	}
+
+	data "selectel_domains_rrset_mapping_v2" "mapping_tf_acc_test_1" {
+	  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+	  project_id = selectel_domains_zone_v2.%[3]s.project_id
+	  depends_on = [
+	    selectel_domains_record_v1.record_a_tf_acc_test_1,
+	    selectel_domains_record_v1.record_a_tf_acc_test_2,
+	  ]
+	}
+`, testAccDomainsZoneV2Basic(projectName, resourceZoneName, domainName+"."), domainName, resourceZoneName)
+}
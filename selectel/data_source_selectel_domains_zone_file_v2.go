@@ -0,0 +1,60 @@
+package selectel
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDomainsZoneFileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsZoneFileV2Read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainsZoneFileV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneName := d.Get("name").(string)
+
+	log.Println(msgGet(objectZone, zoneName))
+
+	zone, err := getZoneByName(ctx, client, zoneName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets, err := listRRSets(ctx, client, zone.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zone.ID)
+	d.Set("zone_id", zone.ID)
+	d.Set("content", renderZoneFile(zone.Name, rrsets))
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package selectel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/mks-go/pkg/v1/cluster"
+)
+
+func dataSourceMKSClustersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMKSClustersV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"kube_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			// computed
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kube_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kube_api_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_window_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_window_end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_autorepair": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_patch_version_auto_upgrade": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cluster_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_kube_api": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cni_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cni_cilium_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"envoy_daemonset": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"hubble_relay": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"enable_audit_logs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"oidc": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"provider_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"issuer_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"username_claim": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"groups_claim": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ca_certs": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						featureGatesKey: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						admissionControllersKey: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMKSClustersV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	mksClient, diagErr := getMKSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	clusters, _, err := cluster.List(ctx, mksClient)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectClusters, err))
+	}
+
+	// Clusters list doesn't contain all cluster options, so every cluster is
+	// requested separately.
+	views := make([]*cluster.GetView, 0, len(clusters))
+	for _, c := range clusters {
+		view, _, err := cluster.Get(ctx, mksClient, c.ID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectCluster, c.ID, err))
+		}
+		views = append(views, view)
+	}
+
+	filter := expandMKSClustersV1SearchFilter(d)
+	filteredClusters := filterMKSClustersV1(views, filter)
+
+	if err := d.Set("clusters", flattenMKSClustersV1(filteredClusters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterIDs := make([]string, len(filteredClusters))
+	for i, c := range filteredClusters {
+		clusterIDs[i] = c.ID
+	}
+
+	checksum, err := stringListChecksum(clusterIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(checksum)
+
+	return nil
+}
+
+type mksClustersV1SearchFilter struct {
+	name        string
+	status      string
+	kubeVersion string
+}
+
+func expandMKSClustersV1SearchFilter(d *schema.ResourceData) mksClustersV1SearchFilter {
+	filter := mksClustersV1SearchFilter{}
+
+	filterSet, ok := d.Get("filter").(*schema.Set)
+	if !ok || filterSet.Len() == 0 {
+		return filter
+	}
+
+	m := filterSet.List()[0].(map[string]any)
+
+	if v, ok := m["name"]; ok {
+		filter.name = v.(string)
+	}
+	if v, ok := m["status"]; ok {
+		filter.status = v.(string)
+	}
+	if v, ok := m["kube_version"]; ok {
+		filter.kubeVersion = strings.TrimPrefix(v.(string), "v")
+	}
+
+	return filter
+}
+
+func filterMKSClustersV1(views []*cluster.GetView, filter mksClustersV1SearchFilter) []*cluster.GetView {
+	filtered := make([]*cluster.GetView, 0, len(views))
+	for _, view := range views {
+		if filter.name != "" && !strings.EqualFold(view.Name, filter.name) {
+			continue
+		}
+		if filter.status != "" && !strings.EqualFold(string(view.Status), filter.status) {
+			continue
+		}
+		// Kubernetes version can be set either with or without the patch part.
+		if filter.kubeVersion != "" && view.KubeVersion != filter.kubeVersion &&
+			!strings.HasPrefix(view.KubeVersion, filter.kubeVersion+".") {
+			continue
+		}
+
+		filtered = append(filtered, view)
+	}
+
+	return filtered
+}
+
+func flattenMKSClustersV1(views []*cluster.GetView) []any {
+	clusters := make([]any, len(views))
+	for i, view := range views {
+		clusters[i] = map[string]any{
+			"id":                                view.ID,
+			"name":                              view.Name,
+			"status":                            string(view.Status),
+			"region":                            view.Region,
+			"kube_version":                      view.KubeVersion,
+			"kube_api_ip":                       view.KubeAPIIP,
+			"network_id":                        view.NetworkID,
+			"subnet_id":                         view.SubnetID,
+			"maintenance_window_start":          view.MaintenanceWindowStart,
+			"maintenance_window_end":            view.MaintenanceWindowEnd,
+			"enable_autorepair":                 view.EnableAutorepair,
+			"enable_patch_version_auto_upgrade": view.EnablePatchVersionAutoUpgrade,
+			"cluster_type":                      view.ClusterType,
+			"private_kube_api":                  view.PrivateKubeAPI,
+			"cni_type":                          view.CNIType,
+			"cni_cilium_settings":               flattenMKSClusterV1CNICiliumSettings(view),
+			"enable_audit_logs":                 view.KubernetesOptions.AuditLogs.Enabled,
+			"oidc":                              flattenMKSClusterV1OIDC(view),
+			featureGatesKey:                     view.KubernetesOptions.FeatureGates,
+			admissionControllersKey:             view.KubernetesOptions.AdmissionControllers,
+		}
+	}
+
+	return clusters
+}
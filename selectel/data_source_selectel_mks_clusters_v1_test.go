@@ -0,0 +1,117 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/mks-go/pkg/v1/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMKSClustersV1DataSourceBasic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	clusterName := acctest.RandomWithPrefix("tf-acc-cl")
+	kubeVersion := testAccMKSClusterV1GetDefaultKubeVersion(t)
+	maintenanceWindowStart := testAccMKSClusterV1GetMaintenanceWindowStart(12 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMKSClustersV1DataSourceBasic(projectName, clusterName, kubeVersion, maintenanceWindowStart),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.selectel_mks_clusters_v1.clusters_tf_acc_test_1", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.selectel_mks_clusters_v1.clusters_tf_acc_test_1", "clusters.0.id",
+						"selectel_mks_cluster_v1.cluster_tf_acc_test_1", "id",
+					),
+					resource.TestCheckResourceAttr("data.selectel_mks_clusters_v1.clusters_tf_acc_test_1", "clusters.0.name", clusterName),
+					resource.TestCheckResourceAttr("data.selectel_mks_clusters_v1.clusters_tf_acc_test_1", "clusters.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.selectel_mks_clusters_v1.clusters_tf_acc_test_1", "clusters.0.region", "ru-9"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMKSClustersV1DataSourceBasic(projectName, clusterName, kubeVersion, maintenanceWindowStart string) string {
+	return fmt.Sprintf(`
+%s
+
+data "selectel_mks_clusters_v1" "clusters_tf_acc_test_1" {
+  project_id = "${selectel_mks_cluster_v1.cluster_tf_acc_test_1.project_id}"
+  region     = "${selectel_mks_cluster_v1.cluster_tf_acc_test_1.region}"
+  filter {
+    name   = "${selectel_mks_cluster_v1.cluster_tf_acc_test_1.name}"
+    status = "ACTIVE"
+  }
+}
+`, testAccMKSClusterV1Basic(projectName, clusterName, kubeVersion, maintenanceWindowStart))
+}
+
+func TestFilterMKSClustersV1(t *testing.T) {
+	views := []*cluster.GetView{
+		{ID: "cluster-1", Name: "prod", Status: "ACTIVE", KubeVersion: "1.29.4"},
+		{ID: "cluster-2", Name: "dev", Status: "ACTIVE", KubeVersion: "1.28.9"},
+		{ID: "cluster-3", Name: "Prod-Old", Status: "ERROR", KubeVersion: "1.28.9"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   mksClustersV1SearchFilter
+		expected []string
+	}{
+		{
+			name:     "empty filter",
+			filter:   mksClustersV1SearchFilter{},
+			expected: []string{"cluster-1", "cluster-2", "cluster-3"},
+		},
+		{
+			name:     "name is case insensitive",
+			filter:   mksClustersV1SearchFilter{name: "PROD"},
+			expected: []string{"cluster-1"},
+		},
+		{
+			name:     "status",
+			filter:   mksClustersV1SearchFilter{status: "active"},
+			expected: []string{"cluster-1", "cluster-2"},
+		},
+		{
+			name:     "minor kube version",
+			filter:   mksClustersV1SearchFilter{kubeVersion: "1.28"},
+			expected: []string{"cluster-2", "cluster-3"},
+		},
+		{
+			name:     "full kube version",
+			filter:   mksClustersV1SearchFilter{kubeVersion: "1.29.4"},
+			expected: []string{"cluster-1"},
+		},
+		{
+			name:     "kube version doesn't match by prefix only",
+			filter:   mksClustersV1SearchFilter{kubeVersion: "1.2"},
+			expected: []string{},
+		},
+		{
+			name:     "combined",
+			filter:   mksClustersV1SearchFilter{status: "ACTIVE", kubeVersion: "1.28"},
+			expected: []string{"cluster-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := filterMKSClustersV1(views, tt.filter)
+
+			ids := make([]string, len(actual))
+			for i, view := range actual {
+				ids[i] = view.ID
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
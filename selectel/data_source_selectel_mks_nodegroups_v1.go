@@ -0,0 +1,309 @@
+package selectel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/mks-go/pkg/v1/nodegroup"
+)
+
+func dataSourceMKSNodegroupsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMKSNodegroupsV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"nodegroup_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			// computed
+			"nodegroups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"volume_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"volume_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_volume": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"nodes_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"enable_autoscale": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"autoscale_min_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"autoscale_max_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"nodegroup_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"install_nvidia_device_plugin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"preemptible": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"taints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"effect": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"hostname": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMKSNodegroupsV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	mksClient, diagErr := getMKSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	nodegroups, _, err := nodegroup.List(ctx, mksClient, clusterID)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectNodegroups, err))
+	}
+
+	// Nodegroups list doesn't contain all nodegroup options, so every nodegroup
+	// is requested separately.
+	views := make([]*nodegroup.GetView, 0, len(nodegroups))
+	for _, ng := range nodegroups {
+		view, _, err := nodegroup.Get(ctx, mksClient, clusterID, ng.ID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectNodegroup, ng.ID, err))
+		}
+		views = append(views, view)
+	}
+
+	filter := expandMKSNodegroupsV1SearchFilter(d)
+	filteredNodegroups := filterMKSNodegroupsV1(views, filter)
+
+	if err := d.Set("nodegroups", flattenMKSNodegroupsV1(filteredNodegroups)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodegroupIDs := make([]string, len(filteredNodegroups))
+	for i, ng := range filteredNodegroups {
+		nodegroupIDs[i] = ng.ID
+	}
+
+	checksum, err := stringListChecksum(append(nodegroupIDs, clusterID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(checksum)
+
+	return nil
+}
+
+type mksNodegroupsV1SearchFilter struct {
+	status           string
+	availabilityZone string
+	nodegroupType    string
+	labels           map[string]string
+}
+
+func expandMKSNodegroupsV1SearchFilter(d *schema.ResourceData) mksNodegroupsV1SearchFilter {
+	filter := mksNodegroupsV1SearchFilter{}
+
+	filterSet, ok := d.Get("filter").(*schema.Set)
+	if !ok || filterSet.Len() == 0 {
+		return filter
+	}
+
+	m := filterSet.List()[0].(map[string]any)
+
+	if v, ok := m["status"]; ok {
+		filter.status = v.(string)
+	}
+	if v, ok := m["availability_zone"]; ok {
+		filter.availabilityZone = v.(string)
+	}
+	if v, ok := m["nodegroup_type"]; ok {
+		filter.nodegroupType = v.(string)
+	}
+	if v, ok := m["labels"]; ok {
+		filter.labels = expandMKSNodegroupV1Labels(v.(map[string]any))
+	}
+
+	return filter
+}
+
+func filterMKSNodegroupsV1(views []*nodegroup.GetView, filter mksNodegroupsV1SearchFilter) []*nodegroup.GetView {
+	filtered := make([]*nodegroup.GetView, 0, len(views))
+	for _, view := range views {
+		if filter.status != "" && !strings.EqualFold(string(view.Status), filter.status) {
+			continue
+		}
+		if filter.availabilityZone != "" && view.AvailabilityZone != filter.availabilityZone {
+			continue
+		}
+		if filter.nodegroupType != "" && !strings.EqualFold(string(view.NodegroupType), filter.nodegroupType) {
+			continue
+		}
+		if !mksNodegroupV1HasLabels(view.Labels, filter.labels) {
+			continue
+		}
+
+		filtered = append(filtered, view)
+	}
+
+	return filtered
+}
+
+// mksNodegroupV1HasLabels reports whether the nodegroup has all the given
+// labels with the same values.
+func mksNodegroupV1HasLabels(nodegroupLabels, labels map[string]string) bool {
+	for key, value := range labels {
+		if v, ok := nodegroupLabels[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenMKSNodegroupsV1(views []*nodegroup.GetView) []any {
+	nodegroups := make([]any, len(views))
+	for i, view := range views {
+		nodegroups[i] = map[string]any{
+			"id":                           view.ID,
+			"status":                       string(view.Status),
+			"availability_zone":            view.AvailabilityZone,
+			"flavor_id":                    view.FlavorID,
+			"volume_gb":                    view.VolumeGB,
+			"volume_type":                  view.VolumeType,
+			"local_volume":                 view.LocalVolume,
+			"nodes_count":                  len(view.Nodes),
+			"enable_autoscale":             view.EnableAutoscale,
+			"autoscale_min_nodes":          view.AutoscaleMinNodes,
+			"autoscale_max_nodes":          view.AutoscaleMaxNodes,
+			"nodegroup_type":               view.NodegroupType,
+			"install_nvidia_device_plugin": view.InstallNvidiaDevicePlugin,
+			"preemptible":                  view.Preemptible,
+			"labels":                       view.Labels,
+			"taints":                       flattenMKSNodegroupV1Taints(view.Taints),
+			"nodes":                        flattenMKSNodegroupV1Nodes(view.Nodes),
+		}
+	}
+
+	return nodegroups
+}
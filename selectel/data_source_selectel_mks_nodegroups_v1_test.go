@@ -0,0 +1,112 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/mks-go/pkg/v1/nodegroup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMKSNodegroupsV1DataSourceBasic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	clusterName := acctest.RandomWithPrefix("tf-acc-cl")
+	kubeVersion := testAccMKSClusterV1GetDefaultKubeVersion(t)
+	maintenanceWindowStart := testAccMKSClusterV1GetMaintenanceWindowStart(12 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMKSNodegroupsV1DataSourceBasic(projectName, clusterName, kubeVersion, maintenanceWindowStart),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.0.availability_zone", "ru-9a"),
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.0.nodes_count", "2"),
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.0.nodes.#", "2"),
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.0.labels.label-key0", "label-value0"),
+					resource.TestCheckResourceAttr("data.selectel_mks_nodegroups_v1.nodegroups_tf_acc_test_1", "nodegroups.0.taints.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMKSNodegroupsV1DataSourceBasic(projectName, clusterName, kubeVersion, maintenanceWindowStart string) string {
+	return fmt.Sprintf(`
+%s
+
+data "selectel_mks_nodegroups_v1" "nodegroups_tf_acc_test_1" {
+  project_id = "${selectel_mks_nodegroup_v1.nodegroup_tf_acc_test_1.project_id}"
+  region     = "${selectel_mks_nodegroup_v1.nodegroup_tf_acc_test_1.region}"
+  cluster_id = "${selectel_mks_nodegroup_v1.nodegroup_tf_acc_test_1.cluster_id}"
+  filter {
+    labels = {
+      label-key0 = "label-value0"
+    }
+  }
+}
+`, testAccMKSNodegroupV1Basic(projectName, clusterName, kubeVersion, maintenanceWindowStart))
+}
+
+func TestFilterMKSNodegroupsV1(t *testing.T) {
+	views := []*nodegroup.GetView{
+		{ID: "ng-1", Status: "ACTIVE", AvailabilityZone: "ru-9a", Labels: map[string]string{"team": "a", "env": "prod"}},
+		{ID: "ng-2", Status: "ACTIVE", AvailabilityZone: "ru-9b", Labels: map[string]string{"team": "b", "env": "prod"}},
+		{ID: "ng-3", Status: "PENDING_SCALE_UP", AvailabilityZone: "ru-9a"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   mksNodegroupsV1SearchFilter
+		expected []string
+	}{
+		{
+			name:     "empty filter",
+			filter:   mksNodegroupsV1SearchFilter{},
+			expected: []string{"ng-1", "ng-2", "ng-3"},
+		},
+		{
+			name:     "status",
+			filter:   mksNodegroupsV1SearchFilter{status: "active"},
+			expected: []string{"ng-1", "ng-2"},
+		},
+		{
+			name:     "availability zone",
+			filter:   mksNodegroupsV1SearchFilter{availabilityZone: "ru-9a"},
+			expected: []string{"ng-1", "ng-3"},
+		},
+		{
+			name:     "single label",
+			filter:   mksNodegroupsV1SearchFilter{labels: map[string]string{"env": "prod"}},
+			expected: []string{"ng-1", "ng-2"},
+		},
+		{
+			name:     "all labels must match",
+			filter:   mksNodegroupsV1SearchFilter{labels: map[string]string{"env": "prod", "team": "b"}},
+			expected: []string{"ng-2"},
+		},
+		{
+			name:     "label value must match",
+			filter:   mksNodegroupsV1SearchFilter{labels: map[string]string{"team": "c"}},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := filterMKSNodegroupsV1(views, tt.filter)
+
+			ids := make([]string, len(actual))
+			for i, view := range actual {
+				ids[i] = view.ID
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
@@ -28,9 +28,27 @@ const (
 	kafkaDatastoreType       = "kafka"
 	masterRole               = "MASTER"
 	replicaRole              = "REPLICA"
+
+	// dbaasDefaultBackupRetentionDays is used when the datastore doesn't
+	// return its backup retention period.
+	dbaasDefaultBackupRetentionDays = 7
 )
 
-func getDBaaSClient(d *schema.ResourceData, meta any) (*dbaas.API, diag.Diagnostics) {
+// dbaasRestoreTimeLayouts are the accepted layouts of restore.target_time.
+// Time without a time zone is treated as UTC.
+var dbaasRestoreTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// resourceValueGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff, so clients can be created during the plan.
+type resourceValueGetter interface {
+	Get(key string) any
+}
+
+func getDBaaSClient(d resourceValueGetter, meta any) (*dbaas.API, diag.Diagnostics) {
 	config := meta.(*Config)
 	projectID := d.Get("project_id").(string)
 	region := d.Get("region").(string)
@@ -40,17 +58,17 @@ func getDBaaSClient(d *schema.ResourceData, meta any) (*dbaas.API, diag.Diagnost
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for dbaas: %w", err))
 	}
 
-	err = validateRegion(selvpcClient, DBaaS, region)
+	err = config.validateServiceRegion(selvpcClient, DBaaS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
 
-	endpoint, err := selvpcClient.Catalog.GetEndpoint(DBaaS, region)
+	endpoint, err := config.getEndpoint(selvpcClient, DBaaS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init dbaas client: %w", err))
 	}
 
-	client, err := dbaas.NewDBAASClient(selvpcClient.GetXAuthToken(), endpoint.URL)
+	client, err := dbaas.NewDBAASClientV1WithCustomHTTP(config.GetHTTPClient(DBaaS), config.GetXAuthToken(selvpcClient), endpoint)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't create dbaas client: %w", err))
 	}
@@ -198,6 +216,88 @@ func resourceDBaaSDatastoreV1RestoreOptsFromSet(restoreSet *schema.Set) (*dbaas.
 	return restore, nil
 }
 
+func parseDBaaSRestoreTime(value string) (time.Time, error) {
+	for _, layout := range dbaasRestoreTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a valid time, use RFC 3339 format, for example, 2006-01-02T15:04:05Z", value)
+}
+
+// dbaasDatastoreV1RestoreWindow returns an estimate of the period within which
+// the datastore state can be restored. The API doesn't return backups, so the
+// period covers the backup retention days up to the current time, but not
+// earlier than the datastore creation.
+func dbaasDatastoreV1RestoreWindow(datastore dbaas.Datastore, now time.Time) (time.Time, time.Time, bool) {
+	if !datastore.AllowRestore {
+		return time.Time{}, time.Time{}, false
+	}
+
+	retentionDays := datastore.BackupRetentionDays
+	if retentionDays == 0 {
+		retentionDays = dbaasDefaultBackupRetentionDays
+	}
+
+	end := now.UTC()
+	start := end.AddDate(0, 0, -retentionDays)
+	if createdAt, err := parseDBaaSRestoreTime(datastore.CreationFinishedAt); err == nil && createdAt.After(start) {
+		start = createdAt
+	}
+
+	return start, end, true
+}
+
+// validateDBaaSDatastoreV1RestoreTargetTime checks that the source datastore
+// can be restored and restore.target_time is inside the estimated restore
+// window, so a wrong time fails during the plan instead of the datastore
+// creation.
+func validateDBaaSDatastoreV1RestoreTargetTime(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() != "" || !diff.NewValueKnown("restore") {
+		return nil
+	}
+
+	restore, err := resourceDBaaSDatastoreV1RestoreOptsFromSet(diff.Get("restore").(*schema.Set))
+	if err != nil || restore == nil || restore.TargetTime == "" {
+		return nil
+	}
+
+	targetTime, err := parseDBaaSRestoreTime(restore.TargetTime)
+	if err != nil {
+		return errParseDatastoreV1Restore(err)
+	}
+
+	if restore.DatastoreID == "" || !diff.NewValueKnown("project_id") || !diff.NewValueKnown("region") {
+		return nil
+	}
+
+	dbaasClient, diagErr := getDBaaSClient(diff, meta)
+	if diagErr != nil {
+		log.Printf("[WARN] unable to check restore target time during the plan: %v", diagErr)
+		return nil
+	}
+
+	log.Print(msgGet(objectDatastore, restore.DatastoreID))
+	datastore, err := dbaasClient.Datastore(ctx, restore.DatastoreID)
+	if err != nil {
+		log.Printf("[WARN] unable to check restore target time during the plan: %s",
+			errGettingObject(objectDatastore, restore.DatastoreID, err))
+		return nil
+	}
+
+	start, end, ok := dbaasDatastoreV1RestoreWindow(datastore, time.Now())
+	if !ok {
+		return fmt.Errorf("%s %s can't be restored", objectDatastore, restore.DatastoreID)
+	}
+	if targetTime.Before(start) || targetTime.After(end) {
+		return fmt.Errorf("restore.target_time %s is outside of the estimated restore window of %s %s: from %s to %s",
+			restore.TargetTime, objectDatastore, restore.DatastoreID, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
 func resourceDBaaSDatastoreV1FloatingIPsOptsFromSet(floatingIPsSet *schema.Set) (*dbaas.FloatingIPs, error) {
 	if floatingIPsSet.Len() == 0 {
 		return nil, nil
@@ -32,7 +32,7 @@ func newTestDBaaSClient(_ context.Context, rs *terraform.ResourceState, testAccP
 		endpoint = dbaasEndpoint.URL
 	}
 
-	dbaasClient, err := dbaas.NewDBAASClient(selvpcClient.GetXAuthToken(), endpoint)
+	dbaasClient, err := dbaas.NewDBAASClient(config.GetXAuthToken(selvpcClient), endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("can't get dbaas client for dbaas acc tests: %w", err)
 	}
@@ -62,9 +62,56 @@ func getDedicatedClient(d *schema.ResourceData, meta any, withProjectScope bool)
 		}
 	}
 
-	url := "https://api.selectel.ru/servers/v2"
+	url, ok := config.getEndpointOverride(DedicatedServers)
+	if !ok {
+		url = "https://api.selectel.ru/servers/v2"
+	}
+
+	client := dedicated.NewClientV2(config.GetXAuthToken(selvpcClient), url)
+	client.HTTPClient = config.GetHTTPClient(DedicatedServers)
+
+	return client, nil
+}
+
+// getDedicatedServerV1PowerState returns the power state of the server in
+// terms of power_state values. Empty string is returned if the state is unknown.
+func getDedicatedServerV1PowerState(ctx context.Context, dsClient *dedicated.ServiceClient, resourceID string) (string, error) {
+	driverStatus, _, err := dsClient.ShowPowerState(ctx, resourceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get power state: %w", err)
+	}
+
+	if driverStatus == nil {
+		return "", nil
+	}
 
-	return dedicated.NewClientV2(selvpcClient.GetXAuthToken(), url), nil
+	switch {
+	case driverStatus.IsReboot():
+		return dedicatedServerPowerActionReboot, nil
+	case driverStatus.IsOff():
+		return dedicatedServerPowerStateOff, nil
+	case driverStatus.IsOn():
+		return dedicatedServerPowerStateOn, nil
+	default:
+		return string(driverStatus.PowerState), nil
+	}
+}
+
+func flattenDedicatedLocalDrives(localDrives dedicated.LocalDrives) []any {
+	res := make([]any, 0, len(localDrives))
+	for _, ldID := range sortedLocalDriveIDs(localDrives) {
+		drive := map[string]any{
+			"id": ldID,
+		}
+		if match := localDrives[ldID].Match; match != nil {
+			drive["type"] = match.Type
+			drive["size"] = match.Size
+		}
+
+		res = append(res, drive)
+	}
+
+	return res
 }
 
 // Partition config item types (API).
@@ -215,6 +262,18 @@ func (pc *PartitionsConfig) ensureDefaultConfig(
 	return nil
 }
 
+// sortedLocalDriveIDs returns local drive IDs in a stable order, so the same
+// partitions config always results in the same drives allocation.
+func sortedLocalDriveIDs(localDrives dedicated.LocalDrives) []string {
+	ids := make([]string, 0, len(localDrives))
+	for ldID := range localDrives {
+		ids = append(ids, ldID)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
 func buildBaseAPIConfig(localDrives dedicated.LocalDrives) dedicated.PartitionsConfig {
 	res := make(dedicated.PartitionsConfig)
 
@@ -240,7 +299,8 @@ func (pc *PartitionsConfig) allocateSoftRaids(
 	for _, sr := range pc.SoftRaidConfig {
 		var candidates []string
 
-		for ldID, ld := range localDrives {
+		for _, ldID := range sortedLocalDriveIDs(localDrives) {
+			ld := localDrives[ldID]
 			if usedDrives[ldID] {
 				continue
 			}
@@ -275,7 +335,8 @@ func (pc *PartitionsConfig) allocateDiskConfigs(
 	result := make(map[string]string)
 
 	for _, dc := range pc.DiskConfig {
-		for ldID, ld := range localDrives {
+		for _, ldID := range sortedLocalDriveIDs(localDrives) {
+			ld := localDrives[ldID]
 			if usedDrives[ldID] {
 				continue
 			}
@@ -357,7 +418,8 @@ func (pc *PartitionsConfig) resolveDevicesForPartition(
 	bestRatio := -1
 	bestSize := -1
 	bestID := ""
-	for ldID, ld := range localDrives {
+	for _, ldID := range sortedLocalDriveIDs(localDrives) {
+		ld := localDrives[ldID]
 		if ld.Match == nil {
 			continue
 		}
@@ -48,7 +48,7 @@ func newTestDedicatedAPIClient(rs *terraform.ResourceState, testAccProvider *sch
 
 	url := "https://api.selectel.ru/servers/v2"
 
-	return dedicated.NewClientV2(selvpcClient.GetXAuthToken(), url)
+	return dedicated.NewClientV2(config.GetXAuthToken(selvpcClient), url)
 }
 
 func TestPartitionsConfig_IsEmpty(t *testing.T) {
@@ -1837,3 +1837,19 @@ func TestResourceDedicatedServerV1ReadExistingRaidConfigOrder(t *testing.T) {
 		_ = resourceDedicatedServerV1ReadExistingRaidConfigOrder
 	})
 }
+
+func TestFlattenDedicatedLocalDrives(t *testing.T) {
+	localDrives := dedicated.LocalDrives{
+		"drive-b": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 2000, Type: "HDD"}},
+		"drive-a": {Type: "local_drive", Match: &dedicated.LocalDriveMatch{Size: 1000, Type: "SSD"}},
+		"drive-c": {Type: "local_drive"},
+	}
+
+	expected := []any{
+		map[string]any{"id": "drive-a", "type": "SSD", "size": 1000},
+		map[string]any{"id": "drive-b", "type": "HDD", "size": 2000},
+		map[string]any{"id": "drive-c"},
+	}
+
+	assert.Equal(t, expected, flattenDedicatedLocalDrives(localDrives))
+}
@@ -1,19 +1,16 @@
 package selectel
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
-	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
 	domainsV1 "github.com/selectel/domains-go/pkg/v1"
-)
-
-const (
-	domainsV1DefaultMinRetryWait = time.Second
-	domainsV1DefaultMaxRetryWait = 5 * time.Second
-	domainsV1DefaultRetry        = 5
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
 )
 
 func getDomainsClient(meta any) (*domainsV1.ServiceClient, error) {
@@ -24,14 +21,13 @@ func getDomainsClient(meta any) (*domainsV1.ServiceClient, error) {
 		return nil, fmt.Errorf("can't get selvpc client for domains: %w", err)
 	}
 
-	domainsClient := domainsV1.NewDomainsClientV1WithDefaultEndpoint(selvpcClient.GetXAuthToken()).WithOSToken()
-
-	retryClient := retryablehttp.NewClient()
-	retryClient.Logger = nil // Ignore retyablehttp client logs
-	retryClient.RetryWaitMin = domainsV1DefaultMinRetryWait
-	retryClient.RetryWaitMax = domainsV1DefaultMaxRetryWait
-	retryClient.RetryMax = domainsV1DefaultRetry
-	domainsClient.HTTPClient = retryClient.StandardClient()
+	var domainsClient *domainsV1.ServiceClient
+	if endpoint, ok := config.getEndpointOverride(DomainsV1); ok {
+		domainsClient = domainsV1.NewDomainsClientV1(config.GetXAuthToken(selvpcClient), endpoint).WithOSToken()
+	} else {
+		domainsClient = domainsV1.NewDomainsClientV1WithDefaultEndpoint(config.GetXAuthToken(selvpcClient)).WithOSToken()
+	}
+	domainsClient.HTTPClient = config.GetHTTPClient(DomainsV1)
 
 	return domainsClient, nil
 }
@@ -72,6 +68,92 @@ func domainsV1ParseDomainRecordIDsPair(id string) (int, int, error) {
 	return domainID, recordID, nil
 }
 
+// domainsV1RRSetGroup contains IDs of v1 records that are stored in one RRSet
+// in DNS Hosting (actual).
+type domainsV1RRSetGroup struct {
+	Name      string
+	Type      string
+	RecordIDs []int
+}
+
+// groupDomainsV1Records groups records of the domain by name and type as they
+// are stored in RRSets of DNS Hosting (actual). SOA and NS records of the
+// domain are skipped as they are managed by DNS Hosting.
+func groupDomainsV1Records(domainName string, records []*record.View) []domainsV1RRSetGroup {
+	zoneName := zoneFileFQDN(domainName, "")
+
+	var groups []domainsV1RRSetGroup
+	groupByKey := make(map[string]int)
+	for _, recordObj := range records {
+		name := zoneFileFQDN(recordObj.Name, "")
+		recordType := string(recordObj.Type)
+		if recordType == TypeRecordSOA || (recordType == TypeRecordNS && name == zoneName) {
+			continue
+		}
+
+		key := name + "/" + recordType
+		i, ok := groupByKey[key]
+		if !ok {
+			groupByKey[key] = len(groups)
+			groups = append(groups, domainsV1RRSetGroup{Name: name, Type: recordType})
+			i = len(groups) - 1
+		}
+		groups[i].RecordIDs = append(groups[i].RecordIDs, recordObj.ID)
+	}
+
+	for i := range groups {
+		slices.Sort(groups[i].RecordIDs)
+	}
+	slices.SortFunc(groups, func(a, b domainsV1RRSetGroup) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Type, b.Type))
+	})
+
+	return groups
+}
+
+// getDomainsV1DomainName returns the name of the selectel_domains_domain_v1
+// domain, which is the zone name in DNS Hosting (actual).
+func getDomainsV1DomainName(ctx context.Context, meta any, domainID int) (string, error) {
+	client, err := getDomainsClient(meta)
+	if err != nil {
+		return "", err
+	}
+
+	domainObj, _, err := domain.GetByID(ctx, client, domainID)
+	if err != nil {
+		return "", errGettingObject(objectDomain, strconv.Itoa(domainID), err)
+	}
+
+	return zoneFileFQDN(domainObj.Name, ""), nil
+}
+
+// getDomainsV1RecordRRSetKey returns the zone name, the RRSet name and the
+// RRSet type in DNS Hosting (actual) for the <domain_id>/<record_id> ID of
+// the selectel_domains_record_v1 resource.
+func getDomainsV1RecordRRSetKey(ctx context.Context, meta any, id string) (string, string, string, error) {
+	domainID, recordID, err := domainsV1ParseDomainRecordIDsPair(id)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	zoneName, err := getDomainsV1DomainName(ctx, meta, domainID)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	client, err := getDomainsClient(meta)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	recordObj, _, err := record.Get(ctx, client, domainID, recordID)
+	if err != nil {
+		return "", "", "", errGettingObject(objectRecord, id, err)
+	}
+
+	return zoneName, zoneFileFQDN(recordObj.Name, ""), string(recordObj.Type), nil
+}
+
 func getIntPtrOrNil(v any) *int {
 	if v == nil {
 		return nil
@@ -3,6 +3,8 @@ package selectel
 import (
 	"testing"
 
+	"github.com/selectel/domains-go/pkg/v1/record"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,3 +73,51 @@ func TestGetIntPtrOrNil(_ *testing.T) {
 		getIntPtrOrNil(test.input)
 	}
 }
+
+func TestGroupDomainsV1Records(t *testing.T) {
+	records := []*record.View{
+		{ID: 1, Name: "example.com", Type: record.TypeSOA},
+		{ID: 2, Name: "example.com", Type: record.TypeNS},
+		{ID: 5, Name: "www.example.com", Type: record.TypeA},
+		{ID: 3, Name: "www.example.com", Type: record.TypeA},
+		{ID: 4, Name: "example.com", Type: record.TypeMX},
+		{ID: 6, Name: "sub.example.com", Type: record.TypeNS},
+	}
+
+	expected := []domainsV1RRSetGroup{
+		{Name: "example.com.", Type: "MX", RecordIDs: []int{4}},
+		{Name: "sub.example.com.", Type: "NS", RecordIDs: []int{6}},
+		{Name: "www.example.com.", Type: "A", RecordIDs: []int{3, 5}},
+	}
+
+	assert.Equal(t, expected, groupDomainsV1Records("example.com", records))
+}
+
+func TestFlattenDomainsV1RRSetGroups(t *testing.T) {
+	groups := []domainsV1RRSetGroup{
+		{Name: "example.com.", Type: "MX", RecordIDs: []int{4}},
+		{Name: "www.example.com.", Type: "A", RecordIDs: []int{3, 5}},
+	}
+	rrsets := []*domainsV2.RRSet{
+		{ID: "mocked-uuid-1", Name: "www.example.com.", Type: domainsV2.A},
+	}
+
+	expected := []any{
+		map[string]any{
+			"name":       "example.com.",
+			"type":       "MX",
+			"rrset_id":   "",
+			"import_id":  "example.com./example.com./MX",
+			"record_ids": []string{"123/4"},
+		},
+		map[string]any{
+			"name":       "www.example.com.",
+			"type":       "A",
+			"rrset_id":   "mocked-uuid-1",
+			"import_id":  "example.com./www.example.com./A",
+			"record_ids": []string{"123/3", "123/5"},
+		},
+	}
+
+	assert.Equal(t, expected, flattenDomainsV1RRSetGroups(123, "example.com.", groups, rrsets))
+}
@@ -16,7 +16,11 @@ import (
 
 var ErrProjectIDNotSetupForDNSV2 = errors.New("env variable INFRA_PROJECT_ID or variable project_id must be set for the dns v2")
 
-func getDomainsV2Client(d *schema.ResourceData, meta any) (domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], error) {
+// domainsV2ManagedBy is the managed_by value of RRSets created by the
+// provider. The authoritative mode of the zone never deletes them.
+const domainsV2ManagedBy = "terraform-provider-selectel"
+
+func getDomainsV2Client(d resourceValueGetter, meta any) (domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], error) {
 	config := meta.(*Config)
 	projectID := d.Get("project_id").(string)
 
@@ -26,17 +30,17 @@ func getDomainsV2Client(d *schema.ResourceData, meta any) (domainsV2.DNSClient[d
 	}
 
 	userAgent := "terraform-provider"
-	endpoint, err := selvpcClient.Catalog.GetEndpoint(DNSv2, config.AuthRegion)
+	endpoint, err := config.getEndpoint(selvpcClient, DNSv2, config.AuthRegion)
 	if err != nil {
 		return nil, fmt.Errorf("can't get endpoint to init dnsv2 client: %w", err)
 	}
 
-	httpClient := &http.Client{}
+	httpClient := config.GetHTTPClient(DNSv2)
 	hdrs := http.Header{}
-	hdrs.Add("X-Auth-Token", selvpcClient.GetXAuthToken())
+	hdrs.Add("X-Auth-Token", config.GetXAuthToken(selvpcClient))
 	hdrs.Add("User-Agent", userAgent)
 
-	domainsClient := domainsV2.NewClient(endpoint.URL, httpClient, hdrs)
+	domainsClient := domainsV2.NewClient(endpoint, httpClient, hdrs)
 
 	return domainsClient, nil
 }
@@ -104,6 +108,126 @@ func getRRSetByNameAndType(ctx context.Context, client domainsV2.DNSClient[domai
 	return nil, errGettingObject(objectRRSet, fmt.Sprintf("Name: %s. Type: %s.", rrsetName, rrsetType), ErrRRSetNotFound)
 }
 
+// listRRSets returns all RRSets of the zone.
+func listRRSets(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string) ([]*domainsV2.RRSet, error) {
+	optsForListRRSets := map[string]string{
+		"limit":  "1000",
+		"offset": "0",
+	}
+
+	var result []*domainsV2.RRSet
+	for {
+		rrsets, err := client.ListRRSets(ctx, zoneID, &optsForListRRSets)
+		if err != nil {
+			return nil, errGettingObjects(objectRRSet, err)
+		}
+		result = append(result, rrsets.GetItems()...)
+
+		optsForListRRSets["offset"] = strconv.Itoa(rrsets.GetNextOffset())
+		if rrsets.GetNextOffset() == 0 {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// domainsZoneV2RRSetFilter finds RRSets of the zone that are not managed by
+// the configuration in the authoritative mode of the zone.
+type domainsZoneV2RRSetFilter struct {
+	zoneName string
+	managed  map[string]struct{}
+	ignore   []*regexp.Regexp
+}
+
+func newDomainsZoneV2RRSetFilter(d resourceValueGetter) (*domainsZoneV2RRSetFilter, error) {
+	filter := &domainsZoneV2RRSetFilter{
+		zoneName: zoneFileFQDN(d.Get("name").(string), ""),
+		managed:  make(map[string]struct{}),
+	}
+
+	if v, ok := d.Get("managed_rrsets").(*schema.Set); ok {
+		for _, key := range v.List() {
+			name, rrsetType, ok := strings.Cut(key.(string), "/")
+			if !ok {
+				return nil, fmt.Errorf("invalid managed RRSet %q, expected <rrset_name>/<rrset_type>", key)
+			}
+			filter.managed[filter.key(name, rrsetType)] = struct{}{}
+		}
+	}
+
+	if v, ok := d.Get("ignore_patterns").([]any); ok {
+		for _, pattern := range v {
+			r, err := regexp.Compile(pattern.(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid ignore pattern %q: %w", pattern, err)
+			}
+			filter.ignore = append(filter.ignore, r)
+		}
+	}
+
+	return filter, nil
+}
+
+// key returns the fully qualified RRSet name and type joined with a slash.
+// Relative names are completed with the zone name.
+func (f *domainsZoneV2RRSetFilter) key(name, rrsetType string) string {
+	return zoneFileFQDN(name, f.zoneName) + "/" + strings.ToUpper(rrsetType)
+}
+
+func (f *domainsZoneV2RRSetFilter) isUnmanaged(name, rrsetType, managedBy string) bool {
+	// SOA and NS records of the zone are managed by Selectel DNS,
+	// RRSets with managed_by are managed by other systems.
+	if managedBy != "" || rrsetType == string(domainsV2.SOA) {
+		return false
+	}
+	key := f.key(name, rrsetType)
+	if key == f.zoneName+"/"+string(domainsV2.NS) {
+		return false
+	}
+	if _, ok := f.managed[key]; ok {
+		return false
+	}
+	for _, r := range f.ignore {
+		if r.MatchString(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listUnmanagedRRSets returns RRSets of the zone that are not managed by the
+// configuration.
+func listUnmanagedRRSets(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string, filter *domainsZoneV2RRSetFilter) ([]*domainsV2.RRSet, error) {
+	rrsets, err := listRRSets(ctx, client, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	unmanaged := make([]*domainsV2.RRSet, 0)
+	for _, rrset := range rrsets {
+		if filter.isUnmanaged(rrset.Name, string(rrset.Type), rrset.ManagedBy) {
+			unmanaged = append(unmanaged, rrset)
+		}
+	}
+
+	return unmanaged, nil
+}
+
+func flattenUnmanagedRRSets(rrsets []*domainsV2.RRSet) []any {
+	result := make([]any, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		result = append(result, map[string]any{
+			"id":   rrset.ID,
+			"name": rrset.Name,
+			"type": string(rrset.Type),
+		})
+	}
+
+	return result
+}
+
 func setZoneToResourceData(d *schema.ResourceData, zone *domainsV2.Zone) error {
 	d.SetId(zone.ID)
 	d.Set("name", zone.Name)
@@ -34,7 +34,7 @@ func getDomainsV2ClientTest(rs *terraform.ResourceState, testAccProvider *schema
 
 	httpClient := &http.Client{}
 	hdrs := http.Header{}
-	hdrs.Add("X-Auth-Token", selvpcClient.GetXAuthToken())
+	hdrs.Add("X-Auth-Token", config.GetXAuthToken(selvpcClient))
 	hdrs.Add("User-Agent", userAgent)
 
 	domainsClient := domainsV2.NewClient(endpoint.URL, httpClient, hdrs)
@@ -170,3 +170,69 @@ func TestGetRRSetByNameAndType_whenNeededRRSetInResponseWithOffset(t *testing.T)
 	assert.Equal(t, rrsetNameForSearch, rrset.Name)
 	assert.Equal(t, rrsetTypeForSearch, string(rrset.Type))
 }
+
+func TestDomainsZoneV2RRSetFilter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDomainsZoneV2().Schema, map[string]any{
+		"name":           "example.com.",
+		"project_id":     "project-id",
+		"managed_rrsets": []any{"www/A", "Mail.Example.com./mx", "@/TXT"},
+		"ignore_patterns": []any{
+			`^_acme-challenge\.`,
+		},
+	})
+
+	filter, err := newDomainsZoneV2RRSetFilter(d)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		rrsetType string
+		managedBy string
+		unmanaged bool
+	}{
+		{name: "example.com.", rrsetType: "SOA"},
+		{name: "example.com.", rrsetType: "NS"},
+		{name: "www.example.com.", rrsetType: "A"},
+		{name: "mail.example.com.", rrsetType: "MX"},
+		{name: "example.com.", rrsetType: "TXT"},
+		{name: "_acme-challenge.example.com.", rrsetType: "TXT"},
+		{name: "cdn.example.com.", rrsetType: "CNAME", managedBy: "cdn"},
+		{name: "api.example.com.", rrsetType: "A", managedBy: domainsV2ManagedBy},
+		{name: "sub.example.com.", rrsetType: "NS", unmanaged: true},
+		{name: "www.example.com.", rrsetType: "AAAA", unmanaged: true},
+		{name: "ftp.example.com.", rrsetType: "A", unmanaged: true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.unmanaged, filter.isUnmanaged(tc.name, tc.rrsetType, tc.managedBy), tc.name+"/"+tc.rrsetType)
+	}
+}
+
+func TestListUnmanagedRRSets(t *testing.T) {
+	mockedZoneID := "mocked-zone-id"
+	mDNSClient := new(mockedDNSv2Client)
+	ctx := context.Background()
+	opts := &map[string]string{
+		"limit":  "1000",
+		"offset": "0",
+	}
+	mDNSClient.On("ListRRSets", ctx, mockedZoneID, opts).Return(domainsV2.Listable[domainsV2.RRSet](domainsV2.List[domainsV2.RRSet]{
+		Count: 3,
+		Items: []*domainsV2.RRSet{
+			{ID: "mocked-uuid-1", Name: "example.com.", Type: domainsV2.SOA},
+			{ID: "mocked-uuid-2", Name: "www.example.com.", Type: domainsV2.A},
+			{ID: "mocked-uuid-3", Name: "ftp.example.com.", Type: domainsV2.A},
+		},
+	}), nil)
+	filter := &domainsZoneV2RRSetFilter{
+		zoneName: "example.com.",
+		managed:  map[string]struct{}{"www.example.com./A": {}},
+	}
+
+	rrsets, err := listUnmanagedRRSets(ctx, mDNSClient, mockedZoneID, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"id": "mocked-uuid-3", "name": "ftp.example.com.", "type": "A"},
+	}, flattenUnmanagedRRSets(rrsets))
+}
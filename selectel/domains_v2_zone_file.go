@@ -0,0 +1,428 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+// zoneFileRRSet contains records of the same name and type from a zone file.
+type zoneFileRRSet struct {
+	Name    string
+	Type    string
+	TTL     int
+	Records []string
+}
+
+func (r zoneFileRRSet) key() string {
+	return r.Name + "/" + r.Type
+}
+
+// zoneFileEntry is a directive or a resource record, which can span several
+// lines if it contains parentheses.
+type zoneFileEntry struct {
+	line       int
+	ownerBlank bool
+	tokens     []string
+}
+
+var zoneFileSupportedTypes = []domainsV2.RecordType{
+	domainsV2.A,
+	domainsV2.AAAA,
+	domainsV2.ALIAS,
+	domainsV2.CAA,
+	domainsV2.CNAME,
+	domainsV2.MX,
+	domainsV2.NS,
+	domainsV2.SOA,
+	domainsV2.SRV,
+	domainsV2.SSHFP,
+	domainsV2.TXT,
+}
+
+// zoneFileNameFieldByType is the index of the domain name field in the
+// record data. Relative names in these fields are completed with the origin.
+var zoneFileNameFieldByType = map[string]int{
+	string(domainsV2.ALIAS): 0,
+	string(domainsV2.CNAME): 0,
+	string(domainsV2.NS):    0,
+	string(domainsV2.MX):    1,
+	string(domainsV2.SRV):   3,
+}
+
+var zoneFileTTLUnits = map[byte]int{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// parseZoneFile parses an RFC 1035 master file of the zone and groups the
+// records into RRSets. SOA records and NS records of the zone apex are
+// skipped as they are managed by Selectel DNS.
+func parseZoneFile(content, zoneName string) ([]zoneFileRRSet, error) {
+	entries, err := splitZoneFileEntries(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		zone       = zoneFileFQDN(zoneName, "")
+		origin     = zone
+		owner      string
+		defaultTTL = -1
+		lastTTL    = -1
+		rrsets     = make([]zoneFileRRSet, 0)
+		rrsetByKey = make(map[string]int)
+	)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if strings.HasPrefix(tokens[0], "$") && !entry.ownerBlank {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", entry.line)
+				}
+				origin = zoneFileFQDN(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a value", entry.line)
+				}
+				if defaultTTL, err = parseZoneFileTTL(tokens[1]); err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: directive %s is not supported", entry.line, tokens[0])
+			}
+
+			continue
+		}
+
+		if !entry.ownerBlank {
+			owner = zoneFileFQDN(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		if owner != zone && !strings.HasSuffix(owner, "."+zone) {
+			return nil, fmt.Errorf("line %d: %s is outside of the zone %s", entry.line, owner, zone)
+		}
+
+		ttl := -1
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if slices.Contains([]string{"CH", "HS", "CS"}, strings.ToUpper(tokens[0])) {
+				return nil, fmt.Errorf("line %d: class %s is not supported", entry.line, tokens[0])
+			}
+			v, err := parseZoneFileTTL(tokens[0])
+			if err != nil {
+				break
+			}
+			ttl = v
+			tokens = tokens[1:]
+		}
+
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record has no TTL and $TTL is not set", entry.line)
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record must have a type and data", entry.line)
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		if !slices.Contains(zoneFileSupportedTypes, domainsV2.RecordType(recordType)) {
+			return nil, fmt.Errorf("line %d: record type %s is not supported", entry.line, tokens[0])
+		}
+		if recordType == string(domainsV2.SOA) || (recordType == string(domainsV2.NS) && owner == zone) {
+			continue
+		}
+
+		data := slices.Clone(tokens[1:])
+		if i, ok := zoneFileNameFieldByType[recordType]; ok {
+			if i >= len(data) {
+				return nil, fmt.Errorf("line %d: %s record data is incomplete", entry.line, recordType)
+			}
+			data[i] = zoneFileFQDN(data[i], origin)
+		}
+		content := strings.Join(data, " ")
+
+		rrset := zoneFileRRSet{Name: owner, Type: recordType, TTL: ttl}
+		i, ok := rrsetByKey[rrset.key()]
+		if !ok {
+			rrsetByKey[rrset.key()] = len(rrsets)
+			rrsets = append(rrsets, rrset)
+			i = len(rrsets) - 1
+		}
+		if rrsets[i].TTL != ttl {
+			return nil, fmt.Errorf("line %d: records of %s %s have different TTLs", entry.line, owner, recordType)
+		}
+		if !slices.Contains(rrsets[i].Records, content) {
+			rrsets[i].Records = append(rrsets[i].Records, content)
+		}
+	}
+
+	for i := range rrsets {
+		slices.Sort(rrsets[i].Records)
+	}
+	sortZoneFileRRSets(rrsets)
+
+	return rrsets, nil
+}
+
+// splitZoneFileEntries splits the zone file into entries, removes comments,
+// and joins lines inside parentheses.
+func splitZoneFileEntries(content string) ([]zoneFileEntry, error) {
+	var (
+		entries []zoneFileEntry
+		current zoneFileEntry
+		depth   int
+	)
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if depth == 0 {
+			current = zoneFileEntry{
+				line:       i + 1,
+				ownerBlank: line != "" && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		var (
+			token    strings.Builder
+			inQuotes bool
+			escaped  bool
+		)
+		flush := func() {
+			if token.Len() > 0 {
+				current.tokens = append(current.tokens, token.String())
+				token.Reset()
+			}
+		}
+
+	chars:
+		for j := 0; j < len(line); j++ {
+			c := line[j]
+			switch {
+			case escaped:
+				token.WriteByte(c)
+				escaped = false
+			case c == '\\':
+				token.WriteByte(c)
+				escaped = true
+			case c == '"':
+				token.WriteByte(c)
+				inQuotes = !inQuotes
+			case inQuotes:
+				token.WriteByte(c)
+			case c == ';':
+				break chars
+			case c == '(':
+				flush()
+				depth++
+			case c == ')':
+				flush()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unexpected )", i+1)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				flush()
+			default:
+				token.WriteByte(c)
+			}
+		}
+		if inQuotes {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", i+1)
+		}
+		flush()
+
+		if depth == 0 && len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses at the end of the zone file")
+	}
+
+	return entries, nil
+}
+
+// parseZoneFileTTL parses TTL in seconds or in BIND format, for example, 1h30m.
+func parseZoneFileTTL(value string) (int, error) {
+	if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+		return v, nil
+	}
+
+	var (
+		ttl    int
+		number string
+	)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		multiplier, ok := zoneFileTTLUnits[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid TTL %q: %w", value, err)
+		}
+		ttl += n * multiplier
+		number = ""
+	}
+	if number != "" || ttl == 0 && value != "0" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return ttl, nil
+}
+
+// zoneFileFQDN returns the fully qualified lowercase form of the name
+// relative to the origin.
+func zoneFileFQDN(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == "":
+		return name + "."
+	default:
+		return name + "." + origin
+	}
+}
+
+// zoneFileRelativeName returns the name relative to the zone, or @ for the
+// zone apex.
+func zoneFileRelativeName(name, zone string) string {
+	name = strings.ToLower(name)
+	if name == zone {
+		return "@"
+	}
+	if relative, ok := strings.CutSuffix(name, "."+zone); ok {
+		return relative
+	}
+
+	return name
+}
+
+func sortZoneFileRRSets(rrsets []zoneFileRRSet) {
+	slices.SortFunc(rrsets, func(a, b zoneFileRRSet) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Type, b.Type)
+	})
+}
+
+// zoneFileRRSetFromAPI converts an RRSet returned by the API. Disabled
+// records are skipped as they can't be expressed in a zone file.
+func zoneFileRRSetFromAPI(rrset *domainsV2.RRSet) zoneFileRRSet {
+	records := make([]string, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		if !record.Disabled {
+			records = append(records, record.Content)
+		}
+	}
+	slices.Sort(records)
+
+	return zoneFileRRSet{
+		Name:    strings.ToLower(rrset.Name),
+		Type:    string(rrset.Type),
+		TTL:     rrset.TTL,
+		Records: records,
+	}
+}
+
+// zoneFileDisabledRecords returns disabled records of the RRSet that aren't
+// enabled in the zone file. They can't be expressed in a zone file, so they
+// are kept when the RRSet is updated from it.
+func zoneFileDisabledRecords(existing *domainsV2.RRSet, rrset zoneFileRRSet) []domainsV2.RecordItem {
+	var records []domainsV2.RecordItem
+	for _, record := range existing.Records {
+		if record.Disabled && !slices.Contains(rrset.Records, record.Content) {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+func zoneFileRRSetsEqual(a, b zoneFileRRSet) bool {
+	return a.Name == b.Name && a.Type == b.Type && a.TTL == b.TTL && slices.Equal(a.Records, b.Records)
+}
+
+// renderZoneFile renders RRSets of the zone as an RFC 1035 master file.
+// Disabled records are rendered as comments.
+func renderZoneFile(zoneName string, rrsets []*domainsV2.RRSet) string {
+	zone := zoneFileFQDN(zoneName, "")
+
+	sorted := slices.Clone(rrsets)
+	slices.SortFunc(sorted, func(a, b *domainsV2.RRSet) int {
+		if c := strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); c != 0 {
+			return c
+		}
+
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", zone)
+	for _, rrset := range sorted {
+		records := slices.Clone(rrset.Records)
+		slices.SortFunc(records, func(a, b domainsV2.RecordItem) int {
+			return strings.Compare(a.Content, b.Content)
+		})
+
+		name := zoneFileRelativeName(rrset.Name, zone)
+		for _, record := range records {
+			prefix := ""
+			if record.Disabled {
+				prefix = "; disabled: "
+			}
+			fmt.Fprintf(&sb, "%s%s\t%d\tIN\t%s\t%s\n", prefix, name, rrset.TTL, rrset.Type, record.Content)
+		}
+	}
+
+	return sb.String()
+}
+
+func flattenZoneFileRRSets(rrsets []zoneFileRRSet) []any {
+	result := make([]any, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		result = append(result, map[string]any{
+			"name":    rrset.Name,
+			"type":    rrset.Type,
+			"ttl":     rrset.TTL,
+			"records": rrset.Records,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+package selectel
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA  ns1.selectel.org. support.selectel.ru. (
+                 2024010101 ; serial
+                 10800 3600 604800 60 )
+@           IN  NS    ns1.selectel.org.
+@           IN  A     192.0.2.1
+            IN  A     192.0.2.2
+www   300   IN  CNAME @
+mail        IN  MX    10 mx1
+mail        IN  MX    20 mx2.example.net.
+sub         IN  NS    ns1.sub
+@       60  IN  TXT   "v=spf1 include:_spf.example.com ~all" ; spf
+_sip._tcp   IN  SRV   10 60 5060 sip
+`
+
+	rrsets, err := parseZoneFile(content, "example.com")
+	require.NoError(t, err)
+
+	expected := []zoneFileRRSet{
+		{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 3600, Records: []string{"10 60 5060 sip.example.com."}},
+		{Name: "example.com.", Type: "A", TTL: 3600, Records: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "example.com.", Type: "TXT", TTL: 60, Records: []string{`"v=spf1 include:_spf.example.com ~all"`}},
+		{Name: "mail.example.com.", Type: "MX", TTL: 3600, Records: []string{"10 mx1.example.com.", "20 mx2.example.net."}},
+		{Name: "sub.example.com.", Type: "NS", TTL: 3600, Records: []string{"ns1.sub.example.com."}},
+		{Name: "www.example.com.", Type: "CNAME", TTL: 300, Records: []string{"example.com."}},
+	}
+	assert.Equal(t, expected, rrsets)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	testCases := map[string]string{
+		"outside of the zone":   "$TTL 60\nwww.example.org. IN A 192.0.2.1\n",
+		"no TTL":                "www IN A 192.0.2.1\n",
+		"unsupported type":      "$TTL 60\nwww IN PTR example.com.\n",
+		"unsupported directive": "$INCLUDE other.zone\n",
+		"unbalanced":            "$TTL 60\n@ IN TXT ( \"a\"\n",
+		"different TTLs":        "www 60 IN A 192.0.2.1\nwww 120 IN A 192.0.2.2\n",
+		"unterminated quote":    "$TTL 60\n@ IN TXT \"a\n",
+	}
+
+	for name, content := range testCases {
+		_, err := parseZoneFile(content, "example.com.")
+		assert.Error(t, err, name)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testCases := map[string]int{
+		"0":     0,
+		"3600":  3600,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1W":    604800,
+		"2d12h": 216000,
+	}
+
+	for value, expected := range testCases {
+		ttl, err := parseZoneFileTTL(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, ttl, value)
+	}
+
+	for _, value := range []string{"", "h", "1x", "1h5", "-1"} {
+		_, err := parseZoneFileTTL(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	rrsets := []*domainsV2.RRSet{
+		{
+			Name: "www.example.com.",
+			Type: domainsV2.CNAME,
+			TTL:  300,
+			Records: []domainsV2.RecordItem{
+				{Content: "example.com."},
+			},
+		},
+		{
+			Name: "example.com.",
+			Type: domainsV2.A,
+			TTL:  60,
+			Records: []domainsV2.RecordItem{
+				{Content: "192.0.2.2"},
+				{Content: "192.0.2.1"},
+				{Content: "192.0.2.3", Disabled: true},
+			},
+		},
+	}
+
+	expected := "$ORIGIN example.com.\n" +
+		"@\t60\tIN\tA\t192.0.2.1\n" +
+		"@\t60\tIN\tA\t192.0.2.2\n" +
+		"; disabled: @\t60\tIN\tA\t192.0.2.3\n" +
+		"www\t300\tIN\tCNAME\texample.com.\n"
+
+	content := renderZoneFile("example.com", rrsets)
+	assert.Equal(t, expected, content)
+
+	parsed, err := parseZoneFile(content, "example.com.")
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	for _, rrset := range rrsets {
+		assert.Contains(t, parsed, zoneFileRRSetFromAPI(rrset))
+	}
+}
+
+func TestZoneFileDisabledRecords(t *testing.T) {
+	existing := &domainsV2.RRSet{
+		Name: "www.example.com.",
+		Type: domainsV2.A,
+		Records: []domainsV2.RecordItem{
+			{Content: "192.0.2.1"},
+			{Content: "192.0.2.2", Disabled: true},
+			{Content: "192.0.2.3", Disabled: true},
+		},
+	}
+	rrset := zoneFileRRSet{
+		Name:    "www.example.com.",
+		Type:    "A",
+		TTL:     3600,
+		Records: []string{"192.0.2.1", "192.0.2.3"},
+	}
+
+	assert.Equal(t, []domainsV2.RecordItem{{Content: "192.0.2.2", Disabled: true}}, zoneFileDisabledRecords(existing, rrset))
+	assert.Empty(t, zoneFileDisabledRecords(&domainsV2.RRSet{Records: []domainsV2.RecordItem{{Content: "192.0.2.1"}}}, rrset))
+}
+
+func TestListRRSets_withOffset(t *testing.T) {
+	mockedZoneID := "mocked-zone-id"
+	mDNSClient := new(mockedDNSv2Client)
+	ctx := context.Background()
+	nextOffset := 1
+	opts1 := &map[string]string{
+		"limit":  "1000",
+		"offset": "0",
+	}
+	opts2 := &map[string]string{
+		"limit":  "1000",
+		"offset": strconv.Itoa(nextOffset),
+	}
+	mDNSClient.On("ListRRSets", ctx, mockedZoneID, opts1).Return(domainsV2.Listable[domainsV2.RRSet](domainsV2.List[domainsV2.RRSet]{
+		Count:      1,
+		NextOffset: nextOffset,
+		Items:      []*domainsV2.RRSet{{ID: "mocked-uuid-1"}},
+	}), nil).Once()
+	mDNSClient.On("ListRRSets", ctx, mockedZoneID, opts2).Return(domainsV2.Listable[domainsV2.RRSet](domainsV2.List[domainsV2.RRSet]{
+		Count: 1,
+		Items: []*domainsV2.RRSet{{ID: "mocked-uuid-2"}},
+	}), nil).Once()
+
+	rrsets, err := listRRSets(ctx, mDNSClient, mockedZoneID)
+
+	assert.NoError(t, err)
+	require.Len(t, rrsets, 2)
+	assert.Equal(t, "mocked-uuid-1", rrsets[0].ID)
+	assert.Equal(t, "mocked-uuid-2", rrsets[1].ID)
+}
@@ -0,0 +1,112 @@
+package selectel
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient"
+)
+
+// endpointsServiceTypes maps the keys of the provider endpoints block to the
+// service types used to look up endpoints.
+var endpointsServiceTypes = map[string]string{
+	"dbaas":               DBaaS,
+	"mks":                 MKS,
+	"iam":                 IAM,
+	"craas":               CRaaS,
+	"craas_v2":            CRaaSV2,
+	"dedicated":           DedicatedServers,
+	"domains":             DomainsV1,
+	"domains_v2":          DNSv2,
+	"private_dns":         PrivateDNS,
+	"public_net":          PublicNetAPI,
+	"secrets_manager":     SecretsManager,
+	"certificate_manager": CertificateManager,
+	"cloudbackup":         DataProtectV2,
+	"global_router":       GlobalRouter,
+}
+
+// httpLoggingSubsystem returns the name of the tflog subsystem of the service.
+// It matches the key of the service in the endpoints block.
+func httpLoggingSubsystem(serviceType string) string {
+	for key, st := range endpointsServiceTypes {
+		if st == serviceType {
+			return key
+		}
+	}
+
+	return serviceType
+}
+
+func providerEndpointsSchema() *schema.Schema {
+	endpointsSchema := make(map[string]*schema.Schema, len(endpointsServiceTypes))
+	for key, serviceType := range endpointsServiceTypes {
+		endpointsSchema[key] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("Endpoint to use for %s API instead of the one from the catalog", serviceType),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Custom endpoints of Selectel services",
+		Elem: &schema.Resource{
+			Schema: endpointsSchema,
+		},
+	}
+}
+
+func expandProviderEndpoints(v []any) map[string]string {
+	endpoints := make(map[string]string)
+	if len(v) == 0 || v[0] == nil {
+		return endpoints
+	}
+
+	for key, value := range v[0].(map[string]any) {
+		if value.(string) == "" {
+			continue
+		}
+		endpoints[endpointsServiceTypes[key]] = value.(string)
+	}
+
+	return endpoints
+}
+
+// getEndpointOverride returns the endpoint of the service set in the provider
+// endpoints block.
+func (c *Config) getEndpointOverride(serviceType string) (string, bool) {
+	endpoint, ok := c.Endpoints[serviceType]
+
+	return endpoint, ok
+}
+
+// getEndpoint returns the overridden endpoint of the service or looks it up in
+// the Keystone catalog.
+func (c *Config) getEndpoint(selvpcClient *selvpcclient.Client, serviceType, region string) (string, error) {
+	if endpoint, ok := c.getEndpointOverride(serviceType); ok {
+		return endpoint, nil
+	}
+
+	endpoint, err := selvpcClient.Catalog.GetEndpoint(serviceType, region)
+	if err != nil {
+		return "", err
+	}
+
+	return endpoint.URL, nil
+}
+
+// validateServiceRegion checks that the service is available in the region.
+// The check is skipped for services with overridden endpoints since they
+// can be missing from the catalog.
+func (c *Config) validateServiceRegion(selvpcClient *selvpcclient.Client, serviceType, region string) error {
+	if _, ok := c.getEndpointOverride(serviceType); ok {
+		return nil
+	}
+
+	return validateRegion(selvpcClient, serviceType, region)
+}
@@ -0,0 +1,71 @@
+package selectel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandProviderEndpoints(t *testing.T) {
+	endpoints := expandProviderEndpoints([]any{
+		map[string]any{
+			"dbaas":     "http://127.0.0.1:8080/dbaas/v1",
+			"mks":       "https://mks.staging.example.com/v1",
+			"dedicated": "",
+		},
+	})
+
+	assert.Equal(t, map[string]string{
+		DBaaS: "http://127.0.0.1:8080/dbaas/v1",
+		MKS:   "https://mks.staging.example.com/v1",
+	}, endpoints)
+
+	assert.Empty(t, expandProviderEndpoints(nil))
+	assert.Empty(t, expandProviderEndpoints([]any{nil}))
+}
+
+func TestGetConfigEndpoints(t *testing.T) {
+	config, diagErr := getConfig(testProviderResourceData(t, map[string]any{
+		"auth_url":    "https://cloud.api.selcloud.ru/identity/v3/",
+		"auth_region": "ru-1",
+		"domain_name": "123456",
+		"username":    "user",
+		"password":    "secret",
+		"endpoints": []any{
+			map[string]any{
+				"iam":           "http://127.0.0.1:8080/iam/v1",
+				"dedicated":     "http://127.0.0.1:8080/servers/v2",
+				"global_router": "http://127.0.0.1:8080/naas/v1",
+			},
+		},
+	}), "test")
+	require.Nil(t, diagErr)
+
+	endpoint, ok := config.getEndpointOverride(IAM)
+	assert.True(t, ok)
+	assert.Equal(t, "http://127.0.0.1:8080/iam/v1", endpoint)
+
+	endpoint, ok = config.getEndpointOverride(DedicatedServers)
+	assert.True(t, ok)
+	assert.Equal(t, "http://127.0.0.1:8080/servers/v2", endpoint)
+
+	_, ok = config.getEndpointOverride(DBaaS)
+	assert.False(t, ok)
+}
+
+func TestConfigGetEndpointOverride(t *testing.T) {
+	config := &Config{
+		Endpoints: map[string]string{
+			MKS: "http://127.0.0.1:8080/mks/v1",
+		},
+	}
+
+	// The catalog must not be used for overridden services, so a client
+	// without a catalog is enough here.
+	endpoint, err := config.getEndpoint(nil, MKS, "ru-1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080/mks/v1", endpoint)
+
+	assert.NoError(t, config.validateServiceRegion(nil, MKS, "unknown-region"))
+}
@@ -67,7 +67,7 @@ func errUpdatingObject(object, id string, err error) error {
 }
 
 func errGettingObject(object, id string, err error) error {
-	return fmt.Errorf("error getting %s '%s': %s", object, id, err)
+	return fmt.Errorf("error getting %s '%s': %w", object, id, err)
 }
 
 func errDeletingObject(object, id string, err error) error {
@@ -128,7 +128,8 @@ func TestErrGettingObject(t *testing.T) {
 
 	actual := errGettingObject(object, projectID, err)
 
-	assert.Equal(t, expected, actual)
+	assert.EqualError(t, actual, expected.Error())
+	assert.ErrorIs(t, actual, err)
 }
 
 func TestErrDeletingObject(t *testing.T) {
@@ -23,10 +23,16 @@ func getGlobalRouterClient(meta any) (*globalrouter.ServiceClient, diag.Diagnost
 		return nil, diag.FromErr(fmt.Errorf("can't get account-scope selvpc client for global router api: %w", err))
 	}
 
+	apiURL, ok := config.getEndpointOverride(GlobalRouter)
+	if !ok {
+		apiURL = "https://api.selectel.ru/naas/v1"
+	}
+
 	globalrouterClient, err := globalrouter.NewClientV1(
-		selvpcClient.GetXAuthToken(),
-		globalrouter.WithAPIUrl("https://api.selectel.ru/naas/v1"),
+		config.GetXAuthToken(selvpcClient),
+		globalrouter.WithAPIUrl(apiURL),
 		globalrouter.WithClientUserAgent(config.UserAgent),
+		globalrouter.WithCustomHTTPClient(config.GetHTTPClient(GlobalRouter)),
 	)
 	if err != nil {
 		return nil, diag.FromErr(err)
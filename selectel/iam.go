@@ -33,16 +33,20 @@ func getIAMClient(meta any) (*iam.Client, diag.Diagnostics) {
 		return nil, diag.FromErr(fmt.Errorf("can't get selvpc client for iam: %w", err))
 	}
 
-	apiURL, err := getEndpointForIAM(selvpcClient, config.AuthRegion)
-	if err != nil {
-		return nil, diag.FromErr(err)
+	apiURL, ok := config.getEndpointOverride(IAM)
+	if !ok {
+		apiURL, err = getEndpointForIAM(selvpcClient, config.AuthRegion)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
 	}
 	iamClient, err := iam.New(
 		iam.WithAuthOpts(&iam.AuthOpts{
-			KeystoneToken: selvpcClient.GetXAuthToken(),
+			KeystoneToken: config.GetXAuthToken(selvpcClient),
 		}),
 		iam.WithAPIUrl(apiURL),
 		iam.WithUserAgentPrefix(config.UserAgent),
+		iam.WithCustomHTTPClient(config.GetHTTPClient(IAM)),
 	)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't create iam client: %w", err))
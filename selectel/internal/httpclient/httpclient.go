@@ -0,0 +1,140 @@
+// Package httpclient provides the HTTP client that is shared by all service
+// clients of a configured provider instance.
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultMaxRetries     = 5
+	DefaultRetryWaitMin   = time.Second
+	DefaultRetryWaitMax   = 5 * time.Second
+	DefaultRequestTimeout = 150 * time.Second
+)
+
+// Options contains the retry and timeout policy of the client.
+type Options struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between
+	// retries. A Retry-After header of 429 and 503 responses takes precedence.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// RequestTimeout limits every single attempt of a request.
+	RequestTimeout time.Duration
+
+	// Transport is used to make the requests. A clone of
+	// http.DefaultTransport is used if it's not set.
+	Transport http.RoundTripper
+
+	// Logging enables debug logs of requests and responses if it's set.
+	Logging *LoggingOptions
+
+	// RetryServerErrors enables retries of all 5xx responses except 501.
+	// Use it only for APIs that are safe to retry after a server error.
+	RetryServerErrors bool
+}
+
+// New returns an HTTP client that retries requests failed with connection
+// errors or with 429, 502, 503 and 504 status codes. Other 5xx status codes
+// are retried if Options.RetryServerErrors is set.
+func New(opts Options) *http.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil // Ignore retryablehttp client logs
+	retryClient.RetryMax = opts.MaxRetries
+	retryClient.RetryWaitMin = opts.RetryWaitMin
+	if retryClient.RetryWaitMin == 0 {
+		retryClient.RetryWaitMin = DefaultRetryWaitMin
+	}
+	retryClient.RetryWaitMax = opts.RetryWaitMax
+	if retryClient.RetryWaitMax == 0 {
+		retryClient.RetryWaitMax = DefaultRetryWaitMax
+	}
+	retryClient.CheckRetry = CheckRetry
+	if opts.RetryServerErrors {
+		retryClient.CheckRetry = CheckRetryServerErrors
+	}
+	// Return the last response to the service client so it can build its
+	// usual API error from it.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	retryClient.HTTPClient.Timeout = opts.RequestTimeout
+	if retryClient.HTTPClient.Timeout == 0 {
+		retryClient.HTTPClient.Timeout = DefaultRequestTimeout
+	}
+	if opts.Transport != nil {
+		retryClient.HTTPClient.Transport = opts.Transport
+	}
+	if opts.Logging != nil {
+		// Wrap the transport inside the retry client to log every attempt.
+		retryClient.HTTPClient.Transport = NewLoggingTransport(retryClient.HTTPClient.Transport, *opts.Logging)
+	}
+
+	return retryClient.StandardClient()
+}
+
+// CheckRetry retries requests on connection errors and on responses that
+// signal that the API is temporarily unavailable. Other 5xx responses aren't
+// retried since a non-idempotent request could have been already applied.
+// For the same reason, 502 and 504 responses are retried only for idempotent
+// requests.
+func CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable:
+		return true, nil
+	case http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return isIdempotentRequest(resp.Request), nil
+	}
+
+	return false, nil
+}
+
+// CheckRetryServerErrors retries requests like CheckRetry and also retries
+// 5xx responses except 501 of idempotent requests.
+func CheckRetryServerErrors(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err == nil && !isIdempotentRequest(resp.Request) {
+		return CheckRetry(ctx, resp, err)
+	}
+
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// isIdempotentRequest reports whether the request can be repeated without
+// changing the result. A missing request is treated as non-idempotent.
+func isIdempotentRequest(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,225 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httptest"
+)
+
+func newTestClient(maxRetries int, transport http.RoundTripper) *http.Client {
+	return New(Options{
+		MaxRetries:   maxRetries,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+		Transport:    transport,
+	})
+}
+
+func TestClientRetriesTemporaryErrors(t *testing.T) {
+	statuses := []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}
+
+	var attempts int
+	client := newTestClient(5, httptest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := statuses[attempts]
+		attempts++
+
+		resp := httptest.NewFakeResponse(status, `{}`)
+		resp.Request = req
+
+		return resp, nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 4, attempts)
+}
+
+func TestClientRetriesWithRequestBody(t *testing.T) {
+	var bodies []string
+	client := newTestClient(1, httptest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) == 1 {
+			return httptest.NewFakeResponse(http.StatusServiceUnavailable, `{}`), nil
+		}
+
+		return httptest.NewFakeResponse(http.StatusCreated, `{}`), nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://api.example.com/v1/objects", strings.NewReader(`{"name":"object"}`))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"object"}`, `{"name":"object"}`}, bodies)
+}
+
+func TestClientDoesNotRetryOtherErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError} {
+		var attempts int
+		client := newTestClient(3, httptest.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+			attempts++
+
+			return httptest.NewFakeResponse(status, `{}`), nil
+		}))
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, status, resp.StatusCode)
+		assert.Equal(t, 1, attempts, "status %d", status)
+	}
+}
+
+func TestClientRetriesServerErrors(t *testing.T) {
+	statuses := []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}
+
+	var attempts int
+	client := New(Options{
+		MaxRetries:        3,
+		RetryWaitMin:      time.Millisecond,
+		RetryWaitMax:      5 * time.Millisecond,
+		RetryServerErrors: true,
+		Transport: httptest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			status := statuses[attempts]
+			attempts++
+
+			resp := httptest.NewFakeResponse(status, `{}`)
+			resp.Request = req
+
+			return resp, nil
+		}),
+	})
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientDoesNotRetryNonIdempotentRequestsOnServerErrors(t *testing.T) {
+	for _, retryServerErrors := range []bool{false, true} {
+		for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout} {
+			var attempts int
+			client := New(Options{
+				MaxRetries:        3,
+				RetryWaitMin:      time.Millisecond,
+				RetryWaitMax:      5 * time.Millisecond,
+				RetryServerErrors: retryServerErrors,
+				Transport: httptest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+
+					resp := httptest.NewFakeResponse(status, `{}`)
+					resp.Request = req
+
+					return resp, nil
+				}),
+			})
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://api.example.com/v1/objects", strings.NewReader(`{"name":"object"}`))
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, status, resp.StatusCode)
+			assert.Equal(t, 1, attempts, "status %d, retry server errors %t", status, retryServerErrors)
+		}
+	}
+}
+
+func TestClientReturnsLastResponseWhenRetriesExhausted(t *testing.T) {
+	var attempts int
+	client := newTestClient(2, httptest.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		attempts++
+
+		return httptest.NewFakeResponse(http.StatusServiceUnavailable, `{"error":"unavailable"}`), nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientRespectsRetryAfter(t *testing.T) {
+	var attempts int
+	client := newTestClient(1, httptest.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			resp := httptest.NewFakeResponse(http.StatusTooManyRequests, `{}`)
+			resp.Header = http.Header{"Retry-After": []string{"1"}}
+
+			return resp, nil
+		}
+
+		return httptest.NewFakeResponse(http.StatusOK, `{}`), nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+	require.NoError(t, err)
+
+	started := time.Now()
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(started), time.Second)
+}
+
+func TestClientRetriesConnectionErrors(t *testing.T) {
+	var attempts int
+	client := newTestClient(2, httptest.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+
+		return httptest.NewFakeResponse(http.StatusOK, `{}`), nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.example.com/v1/objects", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, attempts)
+}
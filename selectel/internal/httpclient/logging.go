@@ -0,0 +1,265 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogLevelEnvVar is the prefix of environment variables that set the level
+	// of the HTTP logs, for example, TF_LOG_PROVIDER_SELECTEL_DBAAS=DEBUG.
+	LogLevelEnvVar = "TF_LOG_PROVIDER_SELECTEL"
+
+	redactedValue = "***"
+
+	// maxLoggedBodySize limits the size of a logged request or response body.
+	maxLoggedBodySize = 64 * 1024
+)
+
+// sensitiveHeaders are never written to the logs.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Auth-Token",
+	"X-Subject-Token",
+}
+
+// requestIDHeaders contain the ID of a request in the order of preference.
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+}
+
+// sensitiveKeyParts are the parts of JSON keys which values are redacted.
+// Keys are compared in lower case without "_" and "-" characters.
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"token",
+	"secret",
+	"privatekey",
+	"clientkey",
+	"kubeconfig",
+	"authorization",
+}
+
+// LoggingOptions configures the logging transport of a service.
+type LoggingOptions struct {
+	// Subsystem is the name of the tflog subsystem of the service.
+	Subsystem string
+
+	// SensitiveKeys are additional JSON keys which values are redacted.
+	SensitiveKeys []string
+}
+
+// loggingTransport writes every HTTP request and response to the tflog
+// subsystem of a service at the debug level.
+type loggingTransport struct {
+	transport     http.RoundTripper
+	subsystem     string
+	sensitiveKeys map[string]struct{}
+}
+
+// NewLoggingTransport wraps the transport so that it logs requests and
+// responses with redacted credentials and secrets.
+func NewLoggingTransport(transport http.RoundTripper, opts LoggingOptions) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	sensitiveKeys := make(map[string]struct{}, len(opts.SensitiveKeys))
+	for _, key := range opts.SensitiveKeys {
+		sensitiveKeys[normalizeKey(key)] = struct{}{}
+	}
+
+	return &loggingTransport{
+		transport:     transport,
+		subsystem:     opts.Subsystem,
+		sensitiveKeys: sensitiveKeys,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(
+		req.Context(),
+		t.subsystem,
+		tflog.WithLevelFromEnv(LogLevelEnvVar, strings.ToUpper(t.subsystem)),
+	)
+
+	req, reqBody, err := t.readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, t.subsystem, "Sending HTTP request", map[string]any{
+		"http_method":      req.Method,
+		"http_url":         req.URL.String(),
+		"http_req_headers": redactHeaders(req.Header),
+		"http_req_body":    reqBody,
+	})
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, t.subsystem, "HTTP request failed", map[string]any{
+			"http_method":     req.Method,
+			"http_url":        req.URL.String(),
+			"http_latency_ms": latency.Milliseconds(),
+			"error":           err.Error(),
+		})
+
+		return nil, err
+	}
+
+	respBody, err := t.readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, t.subsystem, "Received HTTP response", map[string]any{
+		"http_method":       req.Method,
+		"http_url":          req.URL.String(),
+		"http_status_code":  resp.StatusCode,
+		"http_latency_ms":   latency.Milliseconds(),
+		"http_request_id":   requestID(resp.Header),
+		"http_resp_headers": redactHeaders(resp.Header),
+		"http_resp_body":    respBody,
+	})
+
+	return resp, nil
+}
+
+// readRequestBody returns the redacted request body and a copy of the request
+// with the restored body so the underlying transport can send it.
+func (t *loggingTransport) readRequestBody(req *http.Request) (*http.Request, string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, "", nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading request body: %w", err)
+	}
+	_ = req.Body.Close()
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return req, t.redactBody(body), nil
+}
+
+// readResponseBody returns the redacted response body and restores it for
+// the service client.
+func (t *loggingTransport) readResponseBody(resp *http.Response) (string, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading response body: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return t.redactBody(body), nil
+}
+
+// redactBody replaces values of sensitive keys in JSON bodies. Other bodies,
+// for example, kubeconfigs or certificates, are omitted completely since
+// their content can't be checked.
+func (t *loggingTransport) redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var data any
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON body omitted>", len(body))
+	}
+
+	redacted, err := json.Marshal(t.redactValue(data))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of body omitted>", len(body))
+	}
+	if len(redacted) > maxLoggedBodySize {
+		return string(redacted[:maxLoggedBodySize]) + "...<truncated>"
+	}
+
+	return string(redacted)
+}
+
+func (t *loggingTransport) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if t.isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = t.redactValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = t.redactValue(item)
+		}
+	}
+
+	return value
+}
+
+func (t *loggingTransport) isSensitiveKey(key string) bool {
+	key = normalizeKey(key)
+	if _, ok := t.sensitiveKeys[key]; ok {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for name := range headers {
+		result[name] = headers.Get(name)
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := result[http.CanonicalHeaderKey(name)]; ok {
+			result[http.CanonicalHeaderKey(name)] = redactedValue
+		}
+	}
+
+	return result
+}
+
+func requestID(headers http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := headers.Get(name); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
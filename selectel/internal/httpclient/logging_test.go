@@ -0,0 +1,149 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httptest"
+)
+
+func doLoggedRequest(t *testing.T, opts LoggingOptions, reqBody string, resp *http.Response) ([]map[string]any, string, string) {
+	t.Helper()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	var sentBody string
+	transport := NewLoggingTransport(httptest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			sentBody = string(body)
+		}
+
+		return resp, nil
+	}), opts)
+
+	var body io.Reader
+	if reqBody != "" {
+		body = strings.NewReader(reqBody)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/v1/users", body)
+	require.NoError(t, err)
+	req.Header.Set("X-Auth-Token", "keystone-token")
+	req.Header.Set("Content-Type", "application/json")
+
+	gotResp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer gotResp.Body.Close()
+
+	gotBody, err := io.ReadAll(gotResp.Body)
+	require.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	return entries, sentBody, string(gotBody)
+}
+
+func TestLoggingTransportLogsRequestAndResponse(t *testing.T) {
+	resp := httptest.NewFakeResponse(http.StatusCreated, `{"user":{"id":"123","name":"user"}}`)
+	resp.Header = http.Header{}
+	resp.Header.Set("X-Request-Id", "req-1")
+
+	entries, sentBody, gotBody := doLoggedRequest(t, LoggingOptions{Subsystem: "dbaas"}, `{"user":{"name":"user"}}`, resp)
+
+	assert.Equal(t, `{"user":{"name":"user"}}`, sentBody)
+	assert.Equal(t, `{"user":{"id":"123","name":"user"}}`, gotBody)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Sending HTTP request", entries[0]["@message"])
+	assert.Equal(t, "provider.dbaas", entries[0]["@module"])
+	assert.Equal(t, http.MethodPost, entries[0]["http_method"])
+	assert.Equal(t, "https://api.example.com/v1/users", entries[0]["http_url"])
+	assert.Equal(t, "***", entries[0]["http_req_headers"].(map[string]any)["X-Auth-Token"])
+
+	assert.Equal(t, "Received HTTP response", entries[1]["@message"])
+	assert.Equal(t, float64(http.StatusCreated), entries[1]["http_status_code"])
+	assert.Equal(t, "req-1", entries[1]["http_request_id"])
+	assert.Contains(t, entries[1], "http_latency_ms")
+	assert.Equal(t, `{"user":{"id":"123","name":"user"}}`, entries[1]["http_resp_body"])
+}
+
+func TestLoggingTransportRedactsSecrets(t *testing.T) {
+	resp := httptest.NewFakeResponse(http.StatusOK,
+		`{"credentials":[{"access_key":"AK","secret_key":"SK"}],"token":{"id":"t"},"kubeconfig":"cfg"}`)
+
+	entries, sentBody, gotBody := doLoggedRequest(t, LoggingOptions{Subsystem: "iam"},
+		`{"user":{"name":"user","password":"p@ss"}}`, resp)
+
+	assert.Equal(t, `{"user":{"name":"user","password":"p@ss"}}`, sentBody)
+	assert.Contains(t, gotBody, `"secret_key":"SK"`)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, `{"user":{"name":"user","password":"***"}}`, entries[0]["http_req_body"])
+	assert.Equal(t,
+		`{"credentials":[{"access_key":"AK","secret_key":"***"}],"kubeconfig":"***","token":"***"}`,
+		entries[1]["http_resp_body"])
+}
+
+func TestLoggingTransportRedactsServiceKeys(t *testing.T) {
+	resp := httptest.NewFakeResponse(http.StatusOK, `{"name":"secret","version":{"value":"c2VjcmV0"}}`)
+
+	entries, _, _ := doLoggedRequest(t, LoggingOptions{Subsystem: "secrets_manager", SensitiveKeys: []string{"value"}}, "", resp)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, `{"name":"secret","version":{"value":"***"}}`, entries[1]["http_resp_body"])
+}
+
+func TestLoggingTransportOmitsNonJSONBody(t *testing.T) {
+	kubeconfig := "apiVersion: v1\nusers:\n- user:\n    client-key-data: a2V5\n"
+	resp := httptest.NewFakeResponse(http.StatusOK, kubeconfig)
+
+	entries, _, gotBody := doLoggedRequest(t, LoggingOptions{Subsystem: "mks"}, "", resp)
+
+	assert.Equal(t, kubeconfig, gotBody)
+
+	require.Len(t, entries, 2)
+	assert.NotContains(t, entries[1]["http_resp_body"], "a2V5")
+	assert.Contains(t, entries[1]["http_resp_body"], "non-JSON body omitted")
+}
+
+func TestNewWithLogging(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	var attempts int
+	client := New(Options{
+		MaxRetries: 1,
+		Transport: httptest.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return httptest.NewFakeResponse(http.StatusServiceUnavailable, `{}`), nil
+			}
+
+			return httptest.NewFakeResponse(http.StatusOK, `{}`), nil
+		}),
+		Logging: &LoggingOptions{Subsystem: "mks"},
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/v1/clusters", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	// Every attempt is logged.
+	assert.Len(t, entries, 4)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
@@ -239,6 +240,34 @@ func checkVersionIsSupported(kubeVersions []*kubeversion.View, desiredMinorVersi
 	return false, nil
 }
 
+// mksClusterV1KubeconfigKeys contains the cluster attributes populated from
+// the cluster kubeconfig.
+var mksClusterV1KubeconfigKeys = []string{
+	"raw_config",
+	"server",
+	"cluster_ca_cert",
+	"client_cert",
+	"client_key",
+}
+
+// setMKSClusterV1Kubeconfig requests the cluster kubeconfig and sets its
+// parts into the resource data.
+func setMKSClusterV1Kubeconfig(ctx context.Context, d *schema.ResourceData, client *v1.ServiceClient) error {
+	log.Print(msgGet(objectKubeConfig, d.Id()))
+	parsedKubeconfig, _, err := cluster.GetParsedKubeconfig(ctx, client, d.Id())
+	if err != nil {
+		return errGettingObject(objectKubeConfig, d.Id(), err)
+	}
+
+	d.Set("raw_config", parsedKubeconfig.KubeconfigRaw)
+	d.Set("server", parsedKubeconfig.Server)
+	d.Set("cluster_ca_cert", parsedKubeconfig.ClusterCA)
+	d.Set("client_cert", parsedKubeconfig.ClientCert)
+	d.Set("client_key", parsedKubeconfig.ClientKey)
+
+	return nil
+}
+
 func upgradeMKSClusterV1KubeVersion(ctx context.Context, d *schema.ResourceData, client *v1.ServiceClient) error {
 	oldVersion, newVersion := d.GetChange("kube_version")
 	currentVersion := oldVersion.(string)
@@ -736,17 +765,18 @@ func getMKSClient(d *schema.ResourceData, meta any) (*v1.ServiceClient, diag.Dia
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for mks: %w", err))
 	}
-	err = validateRegion(selvpcClient, MKS, region)
+	err = config.validateServiceRegion(selvpcClient, MKS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
 
-	endpoint, err := selvpcClient.Catalog.GetEndpoint(MKS, region)
+	endpoint, err := config.getEndpoint(selvpcClient, MKS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init mks client: %w", err))
 	}
 
-	mksClient := v1.NewMKSClientV1(selvpcClient.GetXAuthToken(), endpoint.URL)
+	mksClient := v1.NewMKSClientV1(config.GetXAuthToken(selvpcClient), endpoint)
+	mksClient.HTTPClient = config.GetHTTPClient(MKS)
 
 	return mksClient, nil
 }
@@ -956,6 +986,50 @@ func checkQuotasForNodegroup(projectQuotas []*quotas.Quota, nodegroupOpts *nodeg
 	return nil
 }
 
+// mksNodegroupV1QuotaFilters returns filters of the project quotas that are
+// used to check quotas for nodegroup nodes.
+func mksNodegroupV1QuotaFilters(localVolume bool, volumeType string) []func(url.Values) {
+	filters := []func(url.Values){
+		quotas.WithResourceFilter("compute_cores"),
+		quotas.WithResourceFilter("compute_ram"),
+	}
+	if localVolume {
+		filters = append(filters, quotas.WithResourceFilter("volume_gigabytes_local"))
+	} else {
+		// Removing an availability zone from volume type.
+		// For example: `fast.ru-3a` -> `fast`.
+		volumeType = strings.Split(volumeType, ".")[0]
+		resourceName := "volume_gigabytes_" + volumeType
+
+		filters = append(filters, quotas.WithResourceFilter(resourceName))
+	}
+
+	return filters
+}
+
+// mksNodegroupV1MaxNodes returns the maximum number of nodes the nodegroup
+// can have. Autoscaled nodegroups can grow up to autoscale_max_nodes.
+func mksNodegroupV1MaxNodes(nodesCount int, enableAutoscale bool, autoscaleMaxNodes int) int {
+	if enableAutoscale {
+		return max(nodesCount, autoscaleMaxNodes)
+	}
+
+	return nodesCount
+}
+
+// mksDiffValuesUnknown reports whether any of the given values is not known
+// during the plan, for example, when it depends on a resource that is not
+// created yet.
+func mksDiffValuesUnknown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // waitForMKSNodegroupV1Creation waits for the nodegroup to be created. It returns an error if the nodegroup is not created.
 func waitForMKSNodegroupV1Creation(ctx context.Context, mksClient *v1.ServiceClient, clusterID string, timeout time.Duration, existingNodegroups map[string]struct{}) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -994,3 +1068,145 @@ func waitForMKSNodegroupV1Creation(ctx context.Context, mksClient *v1.ServiceCli
 
 	return nodegroupID, nil
 }
+
+// checkMKSNodegroupV1KubeVersion checks that the nodegroup follows the cluster
+// control plane version. Nodegroups can't be upgraded ahead of the cluster
+// and can't be downgraded.
+func checkMKSNodegroupV1KubeVersion(clusterVersion, nodegroupVersion string) error {
+	clusterMinor, err := kubeVersionTrimToMinor(clusterVersion)
+	if err != nil {
+		return fmt.Errorf("error getting a minor part of the cluster version %s: %s", clusterVersion, err)
+	}
+	nodegroupMinor, err := kubeVersionTrimToMinor(nodegroupVersion)
+	if err != nil {
+		return fmt.Errorf("error getting a minor part of the nodegroup version %s: %s", nodegroupVersion, err)
+	}
+	if clusterMinor == nodegroupMinor {
+		return nil
+	}
+
+	greater, err := compareTwoKubeVersionsByMinor(clusterVersion, nodegroupVersion)
+	if err != nil {
+		return fmt.Errorf("error comparing cluster version %s and nodegroup version %s: %s", clusterVersion, nodegroupVersion, err)
+	}
+	if greater == nodegroupVersion {
+		return fmt.Errorf("nodegroup version %s can't be ahead of the cluster version %s, upgrade the cluster first",
+			nodegroupVersion, clusterVersion)
+	}
+
+	return fmt.Errorf("nodegroup version %s doesn't match the cluster version %s", nodegroupVersion, clusterVersion)
+}
+
+// mksNodegroupV1KubeVersionNeedsUpgrade shows if nodes of the nodegroup have
+// to be reinstalled to get the desired version. An empty current version is
+// not known yet, it is read from the cluster by the next refresh.
+func mksNodegroupV1KubeVersionNeedsUpgrade(currentVersion, desiredVersion string) bool {
+	if currentVersion == "" || desiredVersion == "" {
+		return false
+	}
+
+	return strings.TrimPrefix(currentVersion, "v") != strings.TrimPrefix(desiredVersion, "v")
+}
+
+// mksNodegroupV1UpgradeBatches splits nodes into batches that are reinstalled
+// at the same time.
+func mksNodegroupV1UpgradeBatches(nodeIDs []string, batchSize int) [][]string {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0, (len(nodeIDs)+batchSize-1)/batchSize)
+	for start := 0; start < len(nodeIDs); start += batchSize {
+		end := min(start+batchSize, len(nodeIDs))
+		batches = append(batches, nodeIDs[start:end])
+	}
+
+	return batches
+}
+
+// mksNodegroupV1UpgradeOpts contains the budget of a nodegroup rolling upgrade.
+type mksNodegroupV1UpgradeOpts struct {
+	// MaxUnavailable is the number of nodes that are reinstalled at the same time.
+	MaxUnavailable int
+
+	// MaxSurge is the number of extra nodes that are added to the nodegroup
+	// for the time of the upgrade. Extra nodes let reinstall more nodes at
+	// the same time without reducing the nodegroup capacity.
+	MaxSurge int
+
+	// Autoscaled nodegroups can't be resized manually, so surge is skipped.
+	Autoscaled bool
+}
+
+// upgradeMKSNodegroupV1KubeVersion reinstalls nodes of the nodegroup so they
+// get the current Kubernetes version of the cluster control plane.
+// Nodes are reinstalled in batches, and every step is guarded by waiting for
+// the nodegroup to become active.
+func upgradeMKSNodegroupV1KubeVersion(
+	ctx context.Context, client *v1.ServiceClient, clusterID, nodegroupID string,
+	opts mksNodegroupV1UpgradeOpts, timeout time.Duration,
+) error {
+	log.Printf("[DEBUG] waiting for nodegroup %s to become 'ACTIVE' before the upgrade", nodegroupID)
+	if err := waitForMKSNodegroupV1ActiveState(ctx, client, clusterID, nodegroupID, timeout); err != nil {
+		return err
+	}
+
+	ng, _, err := nodegroup.Get(ctx, client, clusterID, nodegroupID)
+	if err != nil {
+		return fmt.Errorf("error getting nodegroup %s: %s", nodegroupID, err)
+	}
+
+	nodeIDs := make([]string, len(ng.Nodes))
+	for i, n := range ng.Nodes {
+		nodeIDs[i] = n.ID
+	}
+	slices.Sort(nodeIDs)
+
+	surge := opts.MaxSurge
+	if opts.Autoscaled && surge > 0 {
+		log.Printf("[DEBUG] skipping surge for autoscaled nodegroup %s", nodegroupID)
+		surge = 0
+	}
+
+	if surge > 0 {
+		resizeOpts := nodegroup.ResizeOpts{
+			Desired: len(nodeIDs) + surge,
+		}
+		log.Printf("[DEBUG] adding %d surge nodes to nodegroup %s", surge, nodegroupID)
+		if _, err := nodegroup.Resize(ctx, client, clusterID, nodegroupID, &resizeOpts); err != nil {
+			return fmt.Errorf("error adding surge nodes to nodegroup %s: %s", nodegroupID, err)
+		}
+		if err := waitForMKSNodegroupV1ActiveState(ctx, client, clusterID, nodegroupID, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range mksNodegroupV1UpgradeBatches(nodeIDs, opts.MaxUnavailable+surge) {
+		for _, nodeID := range batch {
+			log.Printf("[DEBUG] reinstalling node %s of nodegroup %s", nodeID, nodegroupID)
+			if _, err := node.Reinstall(ctx, client, clusterID, nodegroupID, nodeID); err != nil {
+				return fmt.Errorf("error reinstalling node %s of nodegroup %s: %s", nodeID, nodegroupID, err)
+			}
+		}
+
+		log.Printf("[DEBUG] waiting for nodegroup %s to become 'ACTIVE'", nodegroupID)
+		if err := waitForMKSNodegroupV1ActiveState(ctx, client, clusterID, nodegroupID, timeout); err != nil {
+			return err
+		}
+	}
+
+	if surge > 0 {
+		resizeOpts := nodegroup.ResizeOpts{
+			Desired: len(nodeIDs),
+		}
+		log.Printf("[DEBUG] removing %d surge nodes from nodegroup %s", surge, nodegroupID)
+		if _, err := nodegroup.Resize(ctx, client, clusterID, nodegroupID, &resizeOpts); err != nil {
+			return fmt.Errorf("error removing surge nodes from nodegroup %s: %s", nodegroupID, err)
+		}
+		if err := waitForMKSNodegroupV1ActiveState(ctx, client, clusterID, nodegroupID, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package selectel
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +15,7 @@ import (
 	"github.com/selectel/mks-go/pkg/v1/node"
 	"github.com/selectel/mks-go/pkg/v1/nodegroup"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newTestMKSClient(rs *terraform.ResourceState, testAccProvider *schema.Provider) (*v1.ServiceClient, error) {
@@ -38,7 +41,7 @@ func newTestMKSClient(rs *terraform.ResourceState, testAccProvider *schema.Provi
 		endpoint = mksEndpoint.URL
 	}
 
-	mksClient := v1.NewMKSClientV1(selvpcClient.GetXAuthToken(), endpoint)
+	mksClient := v1.NewMKSClientV1(config.GetXAuthToken(selvpcClient), endpoint)
 
 	return mksClient, nil
 }
@@ -1312,3 +1315,134 @@ func TestCheckQuotasForNodegroupOk(t *testing.T) {
 
 	assert.NoError(t, checkQuotasForNodegroup(testQuotas, &testNodegroupOpts))
 }
+
+func TestCheckMKSNodegroupV1KubeVersion(t *testing.T) {
+	assert.NoError(t, checkMKSNodegroupV1KubeVersion("1.29.4", "1.29.4"))
+	assert.NoError(t, checkMKSNodegroupV1KubeVersion("1.29.4", "v1.29.1"))
+
+	assert.EqualError(t, checkMKSNodegroupV1KubeVersion("1.28.9", "1.29.4"),
+		"nodegroup version 1.29.4 can't be ahead of the cluster version 1.28.9, upgrade the cluster first")
+	assert.EqualError(t, checkMKSNodegroupV1KubeVersion("1.29.4", "1.28.9"),
+		"nodegroup version 1.28.9 doesn't match the cluster version 1.29.4")
+	assert.Error(t, checkMKSNodegroupV1KubeVersion("1.29.4", "invalid"))
+}
+
+func TestMKSNodegroupV1KubeVersionNeedsUpgrade(t *testing.T) {
+	assert.True(t, mksNodegroupV1KubeVersionNeedsUpgrade("1.28.9", "1.29.4"))
+	assert.True(t, mksNodegroupV1KubeVersionNeedsUpgrade("1.29.1", "1.29.4"))
+
+	assert.False(t, mksNodegroupV1KubeVersionNeedsUpgrade("", "1.29.4"), "unknown version is read by the refresh")
+	assert.False(t, mksNodegroupV1KubeVersionNeedsUpgrade("1.29.4", "v1.29.4"))
+	assert.False(t, mksNodegroupV1KubeVersionNeedsUpgrade("1.29.4", ""))
+}
+
+func TestMKSNodegroupV1UpgradeBatches(t *testing.T) {
+	nodeIDs := []string{"node-1", "node-2", "node-3", "node-4", "node-5"}
+
+	assert.Equal(t, [][]string{{"node-1"}, {"node-2"}, {"node-3"}, {"node-4"}, {"node-5"}},
+		mksNodegroupV1UpgradeBatches(nodeIDs, 1))
+	assert.Equal(t, [][]string{{"node-1", "node-2"}, {"node-3", "node-4"}, {"node-5"}},
+		mksNodegroupV1UpgradeBatches(nodeIDs, 2))
+	assert.Equal(t, [][]string{nodeIDs}, mksNodegroupV1UpgradeBatches(nodeIDs, 10))
+	assert.Equal(t, [][]string{{"node-1"}, {"node-2"}, {"node-3"}, {"node-4"}, {"node-5"}},
+		mksNodegroupV1UpgradeBatches(nodeIDs, 0))
+	assert.Empty(t, mksNodegroupV1UpgradeBatches(nil, 1))
+}
+
+func TestMKSNodegroupV1MaxNodes(t *testing.T) {
+	assert.Equal(t, 3, mksNodegroupV1MaxNodes(3, false, 10))
+	assert.Equal(t, 10, mksNodegroupV1MaxNodes(3, true, 10))
+	assert.Equal(t, 5, mksNodegroupV1MaxNodes(5, true, 0))
+}
+
+func TestMKSNodegroupV1QuotaRequestOnCreate(t *testing.T) {
+	var (
+		request *nodegroup.CreateOpts
+		ok      bool
+	)
+	r := resourceMKSNodegroupV1()
+	r.CustomizeDiff = func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		request, ok = mksNodegroupV1QuotaRequest(d)
+
+		return nil
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+		"cluster_id":        "cluster-id",
+		"project_id":        "project-id",
+		"region":            "ru-9",
+		"availability_zone": "ru-9a",
+		"nodes_count":       3,
+		"cpus":              2,
+		"ram_mb":            4096,
+		"volume_gb":         20,
+		"volume_type":       "universal.ru-9a",
+	}), nil)
+	require.NoError(t, err)
+	require.True(t, ok, "quotas must be checked for a new nodegroup")
+
+	assert.Equal(t, &nodegroup.CreateOpts{
+		Count:            3,
+		CPUs:             2,
+		RAMMB:            4096,
+		VolumeGB:         20,
+		VolumeType:       "universal.ru-9a",
+		AvailabilityZone: "ru-9a",
+	}, request)
+
+	testQuotas := []*quotas.Quota{
+		{
+			Name:                   "compute_cores",
+			ResourceQuotasEntities: []quotas.ResourceQuotaEntity{{Zone: "ru-9a", Value: 4, Used: 0}},
+		},
+		{
+			Name:                   "compute_ram",
+			ResourceQuotasEntities: []quotas.ResourceQuotaEntity{{Zone: "ru-9a", Value: 65536, Used: 0}},
+		},
+		{
+			Name:                   "volume_gigabytes_universal",
+			ResourceQuotasEntities: []quotas.ResourceQuotaEntity{{Zone: "ru-9a", Value: 1000, Used: 0}},
+		},
+	}
+
+	err = checkQuotasForNodegroup(testQuotas, request)
+
+	assert.EqualError(t, err, "not enough CPU quota to create nodes, free: 4, required: 6")
+}
+
+func TestMKSClusterV1PlannedType(t *testing.T) {
+	testCases := []struct {
+		config   map[string]any
+		expected cluster.ClusterType
+	}{
+		{config: map[string]any{"cluster_type": "basic"}, expected: cluster.ClusterTypeBasic},
+		{config: map[string]any{"zonal": true}, expected: cluster.ClusterTypeBasic},
+		{config: map[string]any{"zonal": false}, expected: cluster.ClusterTypeHighAvailability},
+	}
+
+	for _, tc := range testCases {
+		var (
+			clusterType cluster.ClusterType
+			ok          bool
+		)
+		r := resourceMKSClusterV1()
+		r.CustomizeDiff = func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+			clusterType, ok = mksClusterV1PlannedType(d)
+
+			return nil
+		}
+
+		config := map[string]any{
+			"name":         "cluster",
+			"project_id":   "project-id",
+			"region":       "ru-9",
+			"kube_version": "1.30.1",
+		}
+		maps.Copy(config, tc.config)
+
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		assert.True(t, ok, tc.config)
+		assert.Equal(t, tc.expected, clusterType, tc.config)
+	}
+}
@@ -2,20 +2,12 @@ package selectel
 
 import (
 	"fmt"
-	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	privatedns "github.com/selectel/private-dns-go/pkg/v1"
 )
 
-const (
-	privateDNSDefaultMinRetryWait = time.Second
-	privateDNSDefaultMaxRetryWait = 5 * time.Second
-	privateDNSDefaultRetry        = 5
-)
-
 func getPrivateDNSClient(d *schema.ResourceData, meta any) (*privatedns.PrivateDNSClient, diag.Diagnostics) {
 	config := meta.(*Config)
 	projectID := d.Get("project_id").(string)
@@ -26,26 +18,20 @@ func getPrivateDNSClient(d *schema.ResourceData, meta any) (*privatedns.PrivateD
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for private dns: %w", err))
 	}
 
-	err = validateRegion(selvpcClient, PrivateDNS, region)
+	err = config.validateServiceRegion(selvpcClient, PrivateDNS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
 
-	endpoint, err := selvpcClient.Catalog.GetEndpoint(PrivateDNS, region)
+	endpoint, err := config.getEndpoint(selvpcClient, PrivateDNS, region)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get endpoint to init private dns client: %w", err))
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.Logger = nil
-	retryClient.RetryWaitMin = privateDNSDefaultMinRetryWait
-	retryClient.RetryWaitMax = privateDNSDefaultMaxRetryWait
-	retryClient.RetryMax = privateDNSDefaultRetry
-
 	cfg := &privatedns.Config{
-		URL:        endpoint.URL,
-		AuthToken:  selvpcClient.GetXAuthToken(),
-		HTTPClient: retryClient.StandardClient(),
+		URL:        endpoint,
+		AuthToken:  config.GetXAuthToken(selvpcClient),
+		HTTPClient: config.GetHTTPClient(PrivateDNS),
 		UserAgent:  config.UserAgent,
 	}
 	client := privatedns.NewPrivateDNSClient(cfg)
@@ -33,7 +33,7 @@ func newTestPrivateDNSClient(rs *terraform.ResourceState, testAccProvider *schem
 
 	cfg := &privatedns.Config{
 		URL:       endpoint,
-		AuthToken: selvpcClient.GetXAuthToken(),
+		AuthToken: config.GetXAuthToken(selvpcClient),
 	}
 	client := privatedns.NewPrivateDNSClient(cfg)
 
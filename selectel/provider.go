@@ -2,9 +2,13 @@ package selectel
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httpclient"
 	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/mutexkv"
 	"github.com/terraform-providers/terraform-provider-selectel/version"
 )
@@ -31,9 +35,11 @@ const (
 	objectGroup                        = "group"
 	objectGroupMembership              = "group-membership"
 	objectCluster                      = "cluster"
+	objectClusters                     = "clusters"
 	objectKubeConfig                   = "kubeconfig"
 	objectKubeVersions                 = "kube-versions"
 	objectNodegroup                    = "nodegroup"
+	objectNodegroups                   = "nodegroups"
 	objectDomain                       = "domain"
 	objectRecord                       = "record"
 	objectZone                         = "zone"
@@ -96,25 +102,25 @@ func Provider(providerVersion string) *schema.Provider {
 			},
 			"auth_url": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_AUTH_URL", nil),
 				Description: "Base url to work with auth API (Keystone URL).",
 			},
 			"auth_region": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", nil),
 				Description: "Region for Keystone and Resell API URLs.",
 			},
 			"domain_name": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_DOMAIN_NAME", nil),
 				Description: "Your domain name i.e. your account id",
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_USERNAME", nil),
 				Description: "Service user username",
 			},
@@ -125,23 +131,102 @@ func Provider(providerVersion string) *schema.Provider {
 				Description: "Used for service accounts in other domain. If you don't know exactly what this field means then don't use it",
 			},
 			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_PASSWORD", nil),
+				ConflictsWith: []string{"auth_token", "application_credential_id", "application_credential_secret"},
+				Description:   "Service user password",
+			},
+			"auth_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_TOKEN", nil),
+				ConflictsWith: []string{"password", "application_credential_id", "application_credential_secret"},
+				Description:   "Pre-issued Keystone token to use instead of the service user password",
+			},
+			"application_credential_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_ID", nil),
+				ConflictsWith: []string{"password", "auth_token"},
+				RequiredWith:  []string{"application_credential_secret"},
+				Description:   "Application credential ID to use instead of the service user password",
+			},
+			"application_credential_secret": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_SECRET", nil),
+				ConflictsWith: []string{"password", "auth_token"},
+				RequiredWith:  []string{"application_credential_id"},
+				Description:   "Application credential secret",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLIENT_CONFIG_FILE", nil),
+				Description: "Path to a clouds.yaml compatible file to read the profile from",
+			},
+			"profile": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("OS_PASSWORD", nil),
-				Description: "Service user password",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_CLOUD", nil),
+				Description: "Name of the profile in the clouds.yaml compatible file to read credentials from",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      httpclient.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of retries of API requests failed with 429, 502, 503, 504 status codes or connection errors",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      httpclient.DefaultRetryWaitMin.String(),
+				ValidateFunc: validateDuration,
+				Description:  "Minimum time to wait between retries of API requests",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      httpclient.DefaultRetryWaitMax.String(),
+				ValidateFunc: validateDuration,
+				Description:  "Maximum time to wait between retries of API requests unless the API sets Retry-After",
+			},
+			"request_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      httpclient.DefaultRequestTimeout.String(),
+				ValidateFunc: validateDuration,
+				Description:  "Timeout of a single attempt of an API request",
+			},
+			"endpoints": providerEndpointsSchema(),
+			"enable_http_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log API requests and responses with redacted credentials and secrets at the debug level",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"selectel_domains_domain_v1":                dataSourceDomainsDomainV1(),
 			"selectel_domains_zone_v2":                  dataSourceDomainsZoneV2(),
 			"selectel_domains_rrset_v2":                 dataSourceDomainsRRSetV2(),
+			"selectel_domains_zone_file_v2":             dataSourceDomainsZoneFileV2(),
+			"selectel_domains_rrset_mapping_v2":         dataSourceDomainsRRSetMappingV2(),
 			"selectel_dbaas_datastore_type_v1":          dataSourceDBaaSDatastoreTypeV1(),
 			"selectel_dbaas_available_extension_v1":     dataSourceDBaaSAvailableExtensionV1(),
 			"selectel_dbaas_flavor_v1":                  dataSourceDBaaSFlavorV1(),
 			"selectel_dbaas_configuration_parameter_v1": dataSourceDBaaSConfigurationParameterV1(),
 			"selectel_dbaas_prometheus_metric_token_v1": dataSourceDBaaSPrometheusMetricTokenV1(),
+			"selectel_dbaas_backups_v1":                 dataSourceDBaaSBackupsV1(),
 			"selectel_mks_kubeconfig_v1":                dataSourceMKSKubeconfigV1(),
 			"selectel_mks_kube_versions_v1":             dataSourceMKSKubeVersionsV1(),
+			"selectel_mks_clusters_v1":                  dataSourceMKSClustersV1(),
+			"selectel_mks_nodegroups_v1":                dataSourceMKSNodegroupsV1(),
 			"selectel_mks_feature_gates_v1":             dataSourceMKSFeatureGatesV1(),
 			"selectel_mks_admission_controllers_v1":     dataSourceMKSAdmissionControllersV1(),
 			"selectel_dedicated_servers_v1":             dataSourceDedicatedServersV1(),
@@ -150,6 +235,7 @@ func Provider(providerVersion string) *schema.Provider {
 			"selectel_dedicated_location_v1":            dataSourceDedicatedLocationV1(),
 			"selectel_dedicated_public_subnet_v1":       dataSourceDedicatedPublicSubnetV1(),
 			"selectel_dedicated_private_subnet_v1":      dataSourceDedicatedPrivateSubnetV1(),
+			"selectel_dedicated_partitions_plan_v1":     dataSourceDedicatedPartitionsPlanV1(),
 			"selectel_cloudbackup_plan_v2":              dataSourceCloudBackupPlanV2(),
 			"selectel_cloudbackup_checkpoint_v2":        dataSourceCloudBackupCheckpointV2(),
 			"selectel_global_router_service_v1":         dataSourceGlobalRouterServiceV1(),
@@ -180,6 +266,7 @@ func Provider(providerVersion string) *schema.Provider {
 			"selectel_domains_record_v1":                            resourceDomainsRecordV1(),
 			"selectel_domains_zone_v2":                              resourceDomainsZoneV2(),
 			"selectel_domains_rrset_v2":                             resourceDomainsRRSetV2(),
+			"selectel_domains_zone_file_v2":                         resourceDomainsZoneFileV2(),
 			"selectel_dbaas_datastore_v1":                           resourceDBaaSDatastoreV1(), // DEPRECATED
 			"selectel_dbaas_postgresql_datastore_v1":                resourceDBaaSPostgreSQLDatastoreV1(),
 			"selectel_dbaas_mysql_datastore_v1":                     resourceDBaaSMySQLDatastoreV1(),
@@ -238,3 +325,11 @@ func configureProvider(d *schema.ResourceData, userAgent string) (any, diag.Diag
 
 	return config, nil
 }
+
+func validateDuration(v any, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration like 30s or 2m: %w", k, err)}
+	}
+
+	return nil, nil
+}
@@ -2,21 +2,12 @@ package selectel
 
 import (
 	"fmt"
-	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	publicnetapi "github.com/selectel/public-net-api-go/pkg/v1"
 )
 
-const (
-	defaultTimeout      = 150 * time.Second
-	defaultRetryWaitMin = time.Second //nolint: revive
-	defaultRetryWaitMax = 5 * time.Second
-	defaultRetryMax     = 2
-)
-
 func getPublicNetAPIClient(
 	d *schema.ResourceData,
 	meta any,
@@ -32,7 +23,7 @@ func getPublicNetAPIClient(
 		)
 	}
 
-	endpoint, err := selectelVPCClient.Catalog.GetEndpoint(PublicNetAPI, region)
+	endpoint, err := config.getEndpoint(selectelVPCClient, PublicNetAPI, region)
 	if err != nil {
 		return nil, diag.FromErr(
 			fmt.Errorf(
@@ -45,7 +36,7 @@ func getPublicNetAPIClient(
 		)
 	}
 
-	if endpoint.URL == "" {
+	if endpoint == "" {
 		return nil, diag.FromErr(
 			fmt.Errorf(
 				"empty endpoint URL returned for %s (region=%s, project_id=%s)",
@@ -56,17 +47,10 @@ func getPublicNetAPIClient(
 		)
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.Logger = nil
-	retryClient.RetryWaitMin = defaultRetryWaitMin
-	retryClient.RetryWaitMax = defaultRetryWaitMax
-	retryClient.RetryMax = defaultRetryMax
-	retryClient.HTTPClient.Timeout = defaultTimeout
-
 	cfg := &publicnetapi.Config{
-		URL:        endpoint.URL,
-		AuthToken:  selectelVPCClient.GetXAuthToken(),
-		HTTPClient: retryClient.StandardClient(),
+		URL:        endpoint,
+		AuthToken:  config.GetXAuthToken(selectelVPCClient),
+		HTTPClient: config.GetHTTPClient(PublicNetAPI),
 		UserAgent:  config.UserAgent,
 	}
 
@@ -55,7 +55,7 @@ func newTestPublicNetAPI(
 
 	cfg := &publicnetapi.Config{
 		URL:       endpoint.URL,
-		AuthToken: selectelVPCClient.GetXAuthToken(),
+		AuthToken: config.GetXAuthToken(selectelVPCClient),
 	}
 
 	client, err := publicnetapi.NewPublicNetAPIClient(cfg)
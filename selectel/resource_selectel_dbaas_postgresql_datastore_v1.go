@@ -28,6 +28,7 @@ func resourceDBaaSPostgreSQLDatastoreV1() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			refreshDatastoreInstancesOutputsDiff,
+			validateDBaaSDatastoreV1RestoreTargetTime,
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -2,17 +2,23 @@ package selectel
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/dbaas-go"
+	"github.com/selectel/secretsmanager-go/secretsmanagererrors"
+	"github.com/selectel/secretsmanager-go/service/secrets"
 	waiters "github.com/terraform-providers/terraform-provider-selectel/selectel/waiters/dbaas"
 )
 
@@ -31,22 +37,48 @@ func resourceDBaaSUserV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: resourceDBaaSUserV1Schema(),
+		CustomizeDiff: customdiff.All(
+			resourceDBaaSUserV1CustomizeDiffPassword,
+			resourceDBaaSUserV1CustomizeDiffSecret,
+		),
 	}
 }
 
+const (
+	dbaasUserV1PasswordLength = 32
+	dbaasUserV1PasswordLower  = "abcdefghijklmnopqrstuvwxyz"
+	dbaasUserV1PasswordUpper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	dbaasUserV1PasswordDigits = "0123456789"
+
+	dbaasUserV1PasswordSecretKeyTimeLayout = "20060102T150405Z"
+)
+
 func resourceDBaaSUserV1Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	dbaasClient, diagErr := getDBaaSClient(d, meta)
 	if diagErr != nil {
 		return diagErr
 	}
 
+	password := d.Get("password").(string)
+	generatePassword := d.Get("generate_password").(bool)
+	if generatePassword {
+		var err error
+		password, err = generateDBaaSUserV1Password()
+		if err != nil {
+			return diag.FromErr(errCreatingObject(objectUser, err))
+		}
+	}
+
 	userCreateOpts := dbaas.UserCreateOpts{
 		DatastoreID: d.Get("datastore_id").(string),
 		Name:        d.Get("name").(string),
-		Password:    d.Get("password").(string),
+		Password:    password,
 	}
 
-	log.Print(msgCreate(objectUser, userCreateOpts))
+	log.Print(msgCreate(objectUser, dbaas.UserCreateOpts{
+		DatastoreID: userCreateOpts.DatastoreID,
+		Name:        userCreateOpts.Name,
+	}))
 	user, err := dbaasClient.CreateUser(ctx, userCreateOpts)
 	if err != nil {
 		return diag.FromErr(errCreatingObject(objectUser, err))
@@ -61,7 +93,32 @@ func resourceDBaaSUserV1Create(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(user.ID)
 
-	return resourceDBaaSUserV1Read(ctx, d, meta)
+	if generatePassword {
+		d.Set("password", password)
+		d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	var diags diag.Diagnostics
+	if secretKey, ok := d.GetOk("secret.0.key"); ok {
+		storedKey := dbaasUserV1PasswordSecretKey(secretKey.(string), time.Now())
+		diagErr = createDBaaSUserV1PasswordSecret(ctx, d, meta, storedKey, password)
+		if diagErr != nil {
+			// The user is kept, stored_secret_key stays empty and the secret
+			// is created on the next apply.
+			for _, diagnostic := range diagErr {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Password secret is not created",
+					Detail: fmt.Sprintf("The user %s was created, but its password wasn't stored in Secrets Manager, "+
+						"the secret will be created on the next apply: %s", d.Id(), diagnostic.Summary),
+				})
+			}
+		} else {
+			d.Set("stored_secret_key", storedKey)
+		}
+	}
+
+	return append(diags, resourceDBaaSUserV1Read(ctx, d, meta)...)
 }
 
 func resourceDBaaSUserV1Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -88,12 +145,32 @@ func resourceDBaaSUserV1Update(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
-	if d.HasChange("password") {
+	var (
+		password        string
+		passwordChanged bool
+	)
+	switch {
+	// The rotation time is planned as unknown when a new password has to be
+	// generated, see resourceDBaaSUserV1CustomizeDiffPassword.
+	case d.Get("generate_password").(bool) &&
+		(d.Get("password_rotated_at").(string) == "" || d.HasChange("password_rotated_at")):
+		var err error
+		password, err = generateDBaaSUserV1Password()
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
+		}
+		passwordChanged = true
+	case d.HasChange("password"):
+		password = d.Get("password").(string)
+		passwordChanged = true
+	}
+
+	if passwordChanged {
 		updateOpts := dbaas.UserUpdateOpts{
-			Password: d.Get("password").(string),
+			Password: password,
 		}
 
-		log.Print(msgUpdate(objectUser, d.Id(), updateOpts))
+		log.Print(msgUpdate(objectUser, d.Id(), "password"))
 		_, err := dbaasClient.UpdateUser(ctx, d.Id(), updateOpts)
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
@@ -105,6 +182,16 @@ func resourceDBaaSUserV1Update(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
 		}
+
+		d.Set("password", password)
+		if d.Get("generate_password").(bool) {
+			d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
+		}
+	}
+
+	diagErr = updateDBaaSUserV1PasswordSecret(ctx, d, meta, passwordChanged)
+	if diagErr != nil {
+		return diagErr
 	}
 
 	return resourceDBaaSUserV1Read(ctx, d, meta)
@@ -116,6 +203,13 @@ func resourceDBaaSUserV1Delete(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
+	if storedKey, ok := d.GetOk("stored_secret_key"); ok {
+		diagErr = deleteDBaaSUserV1PasswordSecret(ctx, d, meta, storedKey.(string))
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Print(msgDelete(objectUser, d.Id()))
 	err := dbaasClient.DeleteUser(ctx, d.Id())
 	if err != nil {
@@ -154,3 +248,224 @@ func resourceDBaaSUserV1ImportState(_ context.Context, d *schema.ResourceData, m
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceDBaaSUserV1CustomizeDiffPassword plans a new generated password on
+// creation, when the rotation trigger changes, and when the rotation period
+// has passed since the previous rotation.
+func resourceDBaaSUserV1CustomizeDiffPassword(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.Get("generate_password").(bool) {
+		if d.Id() == "" && d.NewValueKnown("password") && d.Get("password").(string) == "" {
+			return errors.New("password must be set when generate_password is disabled")
+		}
+
+		return nil
+	}
+
+	rotate := d.Id() == "" ||
+		d.HasChange("generate_password") ||
+		d.HasChange("rotation_trigger") ||
+		dbaasUserV1PasswordRotationDue(
+			d.Get("password_rotated_at").(string), d.Get("rotation_period").(string), time.Now(),
+		)
+	if !rotate {
+		return nil
+	}
+
+	if err := d.SetNewComputed("password"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("password_rotated_at")
+}
+
+// resourceDBaaSUserV1CustomizeDiffSecret plans a new password secret when the
+// password or the secret settings change, and when the previous write of the
+// secret has failed.
+func resourceDBaaSUserV1CustomizeDiffSecret(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	storedKey := d.Get("stored_secret_key").(string)
+	if d.Get("secret.#").(int) == 0 {
+		if storedKey == "" {
+			return nil
+		}
+
+		return d.SetNew("stored_secret_key", "")
+	}
+
+	if d.HasChanges("secret", "password", "password_rotated_at") ||
+		dbaasUserV1PasswordSecretOutdated(storedKey, d.Get("secret.0.key").(string)) {
+		return d.SetNewComputed("stored_secret_key")
+	}
+
+	return nil
+}
+
+// dbaasUserV1PasswordRotationDue reports whether the rotation period has
+// passed since the password was rotated at the given RFC 3339 time.
+func dbaasUserV1PasswordRotationDue(rotatedAt, period string, now time.Time) bool {
+	if rotatedAt == "" || period == "" {
+		return false
+	}
+
+	rotatedAtTime, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		log.Printf("[DEBUG] can't parse password rotation time %q: %s", rotatedAt, err)
+
+		return false
+	}
+
+	periodDuration, err := time.ParseDuration(period)
+	if err != nil || periodDuration <= 0 {
+		return false
+	}
+
+	return !now.Before(rotatedAtTime.Add(periodDuration))
+}
+
+// generateDBaaSUserV1Password returns a random password with lowercase and
+// uppercase letters and digits. Special characters aren't used as they need
+// escaping in connection strings of some datastore types.
+func generateDBaaSUserV1Password() (string, error) {
+	charset := dbaasUserV1PasswordLower + dbaasUserV1PasswordUpper + dbaasUserV1PasswordDigits
+	charsetLen := big.NewInt(int64(len(charset)))
+
+	for {
+		var sb strings.Builder
+		for range dbaasUserV1PasswordLength {
+			n, err := rand.Int(rand.Reader, charsetLen)
+			if err != nil {
+				return "", fmt.Errorf("error generating password: %w", err)
+			}
+			sb.WriteByte(charset[n.Int64()])
+		}
+
+		password := sb.String()
+		if strings.ContainsAny(password, dbaasUserV1PasswordLower) &&
+			strings.ContainsAny(password, dbaasUserV1PasswordUpper) &&
+			strings.ContainsAny(password, dbaasUserV1PasswordDigits) {
+			return password, nil
+		}
+	}
+}
+
+func createDBaaSUserV1PasswordSecret(
+	ctx context.Context, d *schema.ResourceData, meta any, key, password string,
+) diag.Diagnostics {
+	cl, diagErr := getSecretsManagerClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	secret := secrets.UserSecret{
+		Key:         key,
+		Description: d.Get("secret.0.description").(string),
+		Value:       password,
+	}
+
+	log.Print(msgCreate(objectSecret, secret.Key))
+	if err := cl.Secrets.Create(ctx, secret); err != nil {
+		return diag.FromErr(errCreatingObject(objectSecret, err))
+	}
+
+	return nil
+}
+
+func deleteDBaaSUserV1PasswordSecret(ctx context.Context, d *schema.ResourceData, meta any, key string) diag.Diagnostics {
+	cl, diagErr := getSecretsManagerClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Print(msgDelete(objectSecret, key))
+	err := cl.Secrets.Delete(ctx, key)
+	if err != nil && !errors.Is(err, secretsmanagererrors.ErrNotFoundStatusText) {
+		return diag.FromErr(errDeletingObject(objectSecret, key, err))
+	}
+
+	return nil
+}
+
+// updateDBaaSUserV1PasswordSecret stores the current password in a new
+// secret and deletes the previous one. Every secret gets its own key, so the
+// new secret is always created before the old one is deleted and the value of
+// an existing secret is never changed.
+func updateDBaaSUserV1PasswordSecret(
+	ctx context.Context, d *schema.ResourceData, meta any, passwordChanged bool,
+) diag.Diagnostics {
+	oldStoredKey, _ := d.GetChange("stored_secret_key")
+	storedKey := oldStoredKey.(string)
+	key := d.Get("secret.0.key").(string)
+
+	if key == "" {
+		if storedKey == "" {
+			return nil
+		}
+		if diagErr := deleteDBaaSUserV1PasswordSecret(ctx, d, meta, storedKey); diagErr != nil {
+			d.Set("stored_secret_key", storedKey)
+
+			return diagErr
+		}
+		d.Set("stored_secret_key", "")
+
+		return nil
+	}
+
+	if !passwordChanged && !d.HasChange("secret") && !dbaasUserV1PasswordSecretOutdated(storedKey, key) {
+		d.Set("stored_secret_key", storedKey)
+
+		return nil
+	}
+
+	newStoredKey := dbaasUserV1PasswordSecretKey(key, time.Now())
+	if diagErr := createDBaaSUserV1PasswordSecret(ctx, d, meta, newStoredKey, d.Get("password").(string)); diagErr != nil {
+		if !passwordChanged {
+			// The previous secret still holds the current password, so the
+			// previous state is kept and the secret is planned again.
+			d.Partial(true)
+
+			return diagErr
+		}
+
+		// The previous secret holds the replaced password and isn't useful
+		// anymore. An empty stored_secret_key plans the secret again.
+		if storedKey != "" {
+			if deleteErr := deleteDBaaSUserV1PasswordSecret(ctx, d, meta, storedKey); deleteErr != nil {
+				d.Set("stored_secret_key", storedKey)
+
+				return append(diagErr, deleteErr...)
+			}
+		}
+		d.Set("stored_secret_key", "")
+
+		return diagErr
+	}
+
+	d.Set("stored_secret_key", newStoredKey)
+	if storedKey == "" {
+		return nil
+	}
+
+	return deleteDBaaSUserV1PasswordSecret(ctx, d, meta, storedKey)
+}
+
+// dbaasUserV1PasswordSecretKey returns a key for a new password secret: the
+// configured key with the creation time suffix.
+func dbaasUserV1PasswordSecretKey(key string, now time.Time) string {
+	return key + "-" + now.UTC().Format(dbaasUserV1PasswordSecretKeyTimeLayout)
+}
+
+// dbaasUserV1PasswordSecretOutdated reports whether the stored secret key
+// doesn't belong to the configured key, for example, when the previous write
+// of the secret has failed.
+func dbaasUserV1PasswordSecretOutdated(storedKey, key string) bool {
+	suffix, ok := strings.CutPrefix(storedKey, key+"-")
+	if !ok {
+		return true
+	}
+	_, err := time.Parse(dbaasUserV1PasswordSecretKeyTimeLayout, suffix)
+
+	return err != nil
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/selectel/dbaas-go"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccDBaaSUserV1Basic(t *testing.T) {
@@ -44,6 +48,92 @@ func TestAccDBaaSUserV1Basic(t *testing.T) {
 	})
 }
 
+func TestAccDBaaSUserV1GeneratedPassword(t *testing.T) {
+	var (
+		dbaasUser dbaas.User
+		project   projects.Project
+	)
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	userName := RandomWithPrefix("tf_acc_user")
+	nodeCount := 1
+
+	var firstPassword string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSUserV1GeneratedPassword(projectName, datastoreName, userName, "1", nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					testAccCheckDBaaSUserV1Exists("selectel_dbaas_user_v1.user_tf_acc_test_1", &dbaasUser),
+					resource.TestCheckResourceAttrSet("selectel_dbaas_user_v1.user_tf_acc_test_1", "password_rotated_at"),
+					resource.TestCheckResourceAttrWith("selectel_dbaas_user_v1.user_tf_acc_test_1", "password", func(value string) error {
+						firstPassword = value
+						if len(value) != dbaasUserV1PasswordLength {
+							return fmt.Errorf("expected generated password of %d characters", dbaasUserV1PasswordLength)
+						}
+
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccDBaaSUserV1GeneratedPassword(projectName, datastoreName, userName, "2", nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("selectel_dbaas_user_v1.user_tf_acc_test_1", "password", func(value string) error {
+						if value == firstPassword {
+							return errors.New("expected password to be rotated")
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDBaaSUserV1PasswordRotationDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, dbaasUserV1PasswordRotationDue("", "720h", now))
+	assert.False(t, dbaasUserV1PasswordRotationDue("2026-10-01T12:00:00Z", "", now))
+	assert.False(t, dbaasUserV1PasswordRotationDue("2026-10-01T12:00:00Z", "720h", now))
+	assert.True(t, dbaasUserV1PasswordRotationDue("2026-09-18T12:00:00Z", "720h", now))
+	assert.True(t, dbaasUserV1PasswordRotationDue("2026-08-01T12:00:00Z", "720h", now))
+	assert.False(t, dbaasUserV1PasswordRotationDue("not a time", "720h", now))
+}
+
+func TestDBaaSUserV1PasswordSecretKey(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	storedKey := dbaasUserV1PasswordSecretKey("dbaas-user-password", now)
+
+	assert.Equal(t, "dbaas-user-password-20261018T120000Z", storedKey)
+	assert.False(t, dbaasUserV1PasswordSecretOutdated(storedKey, "dbaas-user-password"))
+	assert.True(t, dbaasUserV1PasswordSecretOutdated("", "dbaas-user-password"))
+	assert.True(t, dbaasUserV1PasswordSecretOutdated(storedKey, "other-password"))
+	assert.True(t, dbaasUserV1PasswordSecretOutdated(storedKey, "dbaas-user"))
+}
+
+func TestGenerateDBaaSUserV1Password(t *testing.T) {
+	first, err := generateDBaaSUserV1Password()
+	require.NoError(t, err)
+
+	second, err := generateDBaaSUserV1Password()
+	require.NoError(t, err)
+
+	assert.Len(t, first, dbaasUserV1PasswordLength)
+	assert.NotEqual(t, first, second)
+	assert.True(t, strings.ContainsAny(first, dbaasUserV1PasswordLower))
+	assert.True(t, strings.ContainsAny(first, dbaasUserV1PasswordUpper))
+	assert.True(t, strings.ContainsAny(first, dbaasUserV1PasswordDigits))
+}
+
 func testAccCheckDBaaSUserV1Exists(n string, dbaasUser *dbaas.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -115,3 +205,47 @@ resource "selectel_dbaas_user_v1" "user_tf_acc_test_1" {
   password = "%s"
 }`, projectName, datastoreName, nodeCount, userName, userPassword)
 }
+
+func testAccDBaaSUserV1GeneratedPassword(projectName, datastoreName, userName, rotationTrigger string, nodeCount int) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+resource "selectel_vpc_subnet_v2" "subnet_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+}
+
+data "selectel_dbaas_datastore_type_v1" "dt" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  filter {
+    engine = "postgresql"
+    version = "12"
+  }
+}
+
+resource "selectel_dbaas_datastore_v1" "datastore_tf_acc_test_1" {
+  name = "%s"
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  subnet_id = "${selectel_vpc_subnet_v2.subnet_tf_acc_test_1.subnet_id}"
+  node_count = "%d"
+  flavor {
+    vcpus = 2
+    ram = 4096
+    disk = 32
+  }
+}
+
+resource "selectel_dbaas_user_v1" "user_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_datastore_v1.datastore_tf_acc_test_1.id}"
+  name = "%s"
+  generate_password = true
+  rotation_trigger = "%s"
+}`, projectName, datastoreName, nodeCount, userName, rotationTrigger)
+}
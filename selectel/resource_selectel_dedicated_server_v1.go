@@ -35,6 +35,23 @@ func resourceDedicatedServerV1() *schema.Resource {
 }
 
 func resourceDedicatedServerV1CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// These params can't be changed for an existing server, so the changes are
+	// rejected during the plan instead of the update.
+	if d.Id() != "" {
+		for _, key := range []string{
+			dedicatedServerSchemaKeyProjectID,
+			dedicatedServerSchemaKeyLocationID,
+			dedicatedServerSchemaKeyConfigurationID,
+			dedicatedServerSchemaKeyPricePlanName,
+		} {
+			if d.HasChange(key) {
+				prev, _ := d.GetChange(key)
+
+				return fmt.Errorf("%s of server %s can't be changed, use previous value %s", key, d.Id(), prev)
+			}
+		}
+	}
+
 	// Validate that power_state change is not combined with other changes
 	if d.HasChange(dedicatedServerSchemaKeyPowerState) {
 		// Skip validation if power_state is not changing (only computed value changing)
@@ -869,24 +886,12 @@ func resourceDedicatedServerV1Read(ctx context.Context, d *schema.ResourceData,
 		_ = d.Set("partitions_config", partitionsConfig)
 	}
 
-	driverStatus, _, err := dsClient.ShowPowerState(ctx, d.Id())
+	powerState, err := getDedicatedServerV1PowerState(ctx, dsClient, d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to get power state: %w", err))
-	}
-
-	if driverStatus != nil {
-		var state string
-		switch {
-		case driverStatus.IsReboot():
-			state = dedicatedServerPowerActionReboot
-		case driverStatus.IsOff():
-			state = dedicatedServerPowerStateOff
-		case driverStatus.IsOn():
-			state = dedicatedServerPowerStateOn
-		default:
-			state = string(driverStatus.PowerState)
-		}
-		_ = d.Set("power_state", state)
+		return diag.FromErr(err)
+	}
+	if powerState != "" {
+		_ = d.Set("power_state", powerState)
 	}
 
 	ips, err := resourceDedicatedServerGetReservedIPs(ctx, dsClient, d.Id())
@@ -908,6 +913,19 @@ func resourceDedicatedServerV1Read(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceDedicatedServerV1Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.Get(dedicatedServerSchemaKeyDeletionProtection).(bool) {
+		return diag.FromErr(fmt.Errorf(
+			"can't delete server %s because %s is enabled: set %s = false and apply changes first",
+			d.Id(), dedicatedServerSchemaKeyDeletionProtection, dedicatedServerSchemaKeyDeletionProtection,
+		))
+	}
+
+	if d.Get(dedicatedServerSchemaKeyOnDestroy).(string) == dedicatedServerOnDestroyAbandon {
+		log.Printf("[WARN] removing server %s from the state without cancelling its rental", d.Id())
+
+		return nil
+	}
+
 	dsClient, diagErr := getDedicatedClient(d, meta, true)
 	if diagErr != nil {
 		return diagErr
@@ -1239,6 +1257,11 @@ func resourceDedicatedServerV1UpdateWithPowerControl(
 		return diagErr
 	}
 
+	// Lifecycle params are stored in the state only.
+	if !d.HasChangesExcept(dedicatedServerSchemaKeyDeletionProtection, dedicatedServerSchemaKeyOnDestroy) {
+		return nil
+	}
+
 	powerStateRaw, ok := d.GetOk(dedicatedServerSchemaKeyPowerState)
 	if !ok {
 		return resourceDedicatedServerV1UpdateWithStateRollback(ctx, d, dsClient, meta)
@@ -1393,6 +1416,8 @@ func resourceDedicatedServerV1ImportState(_ context.Context, d *schema.ResourceD
 	}
 
 	_ = d.Set("project_id", config.ProjectID)
+	_ = d.Set(dedicatedServerSchemaKeyDeletionProtection, false)
+	_ = d.Set(dedicatedServerSchemaKeyOnDestroy, dedicatedServerOnDestroyDelete)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -14,6 +14,7 @@ import (
 	dedicated "github.com/selectel/dedicated-go/v2/pkg/v2"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/terraform-providers/terraform-provider-selectel/selectel/internal/httptest"
 )
 
@@ -694,6 +695,54 @@ func TestDedicatedServerV1ImportState_WithoutProjectID(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestDedicatedServerV1ImportState_LifecycleDefaults(t *testing.T) {
+	d := resourceDedicatedServerV1().TestResourceData()
+	d.SetId("test-server-id")
+
+	_, err := resourceDedicatedServerV1ImportState(context.Background(), d, &Config{ProjectID: "test-project-id"})
+
+	assert.NoError(t, err)
+	assert.False(t, d.Get(dedicatedServerSchemaKeyDeletionProtection).(bool))
+	assert.Equal(t, dedicatedServerOnDestroyDelete, d.Get(dedicatedServerSchemaKeyOnDestroy))
+}
+
+func TestDedicatedServerV1DeleteWithDeletionProtection(t *testing.T) {
+	d := resourceDedicatedServerV1().TestResourceData()
+	d.SetId("test-server-id")
+	_ = d.Set(dedicatedServerSchemaKeyDeletionProtection, true)
+	_ = d.Set(dedicatedServerSchemaKeyOnDestroy, dedicatedServerOnDestroyAbandon)
+
+	diags := resourceDedicatedServerV1Delete(context.Background(), d, &Config{})
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "deletion_protection is enabled")
+	assert.Equal(t, "test-server-id", d.Id())
+}
+
+func TestDedicatedServerV1DeleteWithAbandon(t *testing.T) {
+	d := resourceDedicatedServerV1().TestResourceData()
+	d.SetId("test-server-id")
+	_ = d.Set(dedicatedServerSchemaKeyOnDestroy, dedicatedServerOnDestroyAbandon)
+
+	diags := resourceDedicatedServerV1Delete(context.Background(), d, &Config{})
+
+	assert.False(t, diags.HasError())
+}
+
+func TestDedicatedServerV1OnDestroySchema(t *testing.T) {
+	onDestroySchema := resourceDedicatedServerV1().Schema[dedicatedServerSchemaKeyOnDestroy]
+
+	assert.Equal(t, dedicatedServerOnDestroyDelete, onDestroySchema.Default)
+
+	for _, val := range []string{"delete", "abandon"} {
+		_, errs := onDestroySchema.ValidateFunc(val, dedicatedServerSchemaKeyOnDestroy)
+		assert.Empty(t, errs, "value %q should be valid", val)
+	}
+
+	_, errs := onDestroySchema.ValidateFunc("keep", dedicatedServerSchemaKeyOnDestroy)
+	assert.NotEmpty(t, errs)
+}
+
 func TestDedicatedServerV1PowerStateSchema(t *testing.T) {
 	resource := resourceDedicatedServerV1()
 	powerStateSchema := resource.Schema["power_state"]
@@ -7,15 +7,24 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	waiters "github.com/terraform-providers/terraform-provider-selectel/selectel/waiters/domains"
 )
 
 var ErrRRSetNotFound = errors.New("rrset not found")
 
 func resourceDomainsRRSetV2CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// Wait for the propagation again if the previous wait has timed out.
+	if d.Id() != "" && d.Get("wait_for_propagation").(bool) && !d.Get("propagated").(bool) {
+		if err := d.SetNewComputed("propagated"); err != nil {
+			return err
+		}
+	}
+
 	recordType := d.Get("type").(string)
 	if !strings.EqualFold(recordType, string(domainsV2.TXT)) {
 		return nil
@@ -71,6 +80,10 @@ func resourceDomainsRRSetV2() *schema.Resource {
 			StateContext: resourceDomainsRRSetV2ImportState,
 		},
 		CustomizeDiff: resourceDomainsRRSetV2CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -121,6 +134,22 @@ func resourceDomainsRRSetV2() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"propagation_nameservers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"propagated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -147,9 +176,7 @@ func resourceDomainsRRSetV2Create(ctx context.Context, d *schema.ResourceData, m
 	if comment := d.Get("comment"); comment != nil {
 		createOpts.Comment = comment.(string)
 	}
-	if managedBy := d.Get("managed_by"); managedBy != nil {
-		createOpts.ManagedBy = managedBy.(string)
-	}
+	createOpts.ManagedBy = domainsV2ManagedBy
 
 	rrset, err := client.CreateRRSet(ctx, zoneID, &createOpts)
 	if err != nil {
@@ -161,9 +188,23 @@ func resourceDomainsRRSetV2Create(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errCreatingObject(objectRRSet, err))
 	}
 
+	err = waitForDomainsRRSetV2Propagation(ctx, d, client, d.Timeout(schema.TimeoutCreate))
+	d.Set("propagated", err == nil)
+	if err != nil {
+		return diag.FromErr(errRRSetV2NotPropagated(d.Id(), err))
+	}
+
 	return nil
 }
 
+// errRRSetV2NotPropagated reports that the records aren't propagated yet. The
+// RRSet stays in the state with propagated set to false, so the next apply
+// waits for the propagation again.
+func errRRSetV2NotPropagated(rrsetID string, err error) error {
+	return fmt.Errorf("records of RRSet %s were not propagated within the timeout, "+
+		"the next apply waits for the propagation again: %w", rrsetID, err)
+}
+
 func resourceDomainsRRSetV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client, err := getDomainsV2Client(d, meta)
 	if err != nil {
@@ -195,6 +236,7 @@ func resourceDomainsRRSetV2ImportState(ctx context.Context, d *schema.ResourceDa
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
 	}
 	d.Set("project_id", config.ProjectID)
+	d.Set("wait_for_propagation", false)
 
 	client, err := getDomainsV2Client(d, meta)
 	if err != nil {
@@ -203,6 +245,15 @@ func resourceDomainsRRSetV2ImportState(ctx context.Context, d *schema.ResourceDa
 	// concat zone_name,rrset_name,rrset_type with symbol "/" instead of rrset id for importing rrset.
 	// example: terraform import domains_rrset_v2.<resource_name> <zone_name>/<rrset_name>/<rrset_type>
 	parts := strings.Split(d.Id(), "/")
+	// ID of selectel_domains_record_v1 is also accepted to migrate records to RRSets.
+	// example: terraform import domains_rrset_v2.<resource_name> <domain_id>/<record_id>
+	if len(parts) == 2 {
+		zoneName, rrsetName, rrsetType, err := getDomainsV1RecordRRSetKey(ctx, meta, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		parts = []string{zoneName, rrsetName, rrsetType}
+	}
 	if len(parts) != 3 {
 		return nil, errors.New("id must include three parts: zone_name/rrset_name/rrset_type")
 	}
@@ -254,12 +305,28 @@ func resourceDomainsRRSetV2Update(ctx context.Context, d *schema.ResourceData, m
 		if comment, ok := d.GetOk("comment"); ok {
 			updateOpts.Comment = comment.(string)
 		}
+		// RRSets created before the provider set managed_by get the marker
+		// with the next update of the RRSet.
+		if updateOpts.ManagedBy == "" {
+			updateOpts.ManagedBy = domainsV2ManagedBy
+		}
 		err = client.UpdateRRSet(ctx, zoneID, d.Id(), &updateOpts)
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectRRSet, d.Id(), err))
 		}
 	}
 
+	propagated, _ := d.GetChange("propagated")
+	if d.HasChanges("ttl", "records", "wait_for_propagation", "propagation_nameservers") || !propagated.(bool) {
+		err = waitForDomainsRRSetV2Propagation(ctx, d, client, d.Timeout(schema.TimeoutUpdate))
+		d.Set("propagated", err == nil)
+		if err != nil {
+			return diag.FromErr(errRRSetV2NotPropagated(d.Id(), err))
+		}
+	} else {
+		d.Set("propagated", true)
+	}
+
 	return resourceDomainsRRSetV2Read(ctx, d, meta)
 }
 
@@ -280,3 +347,51 @@ func resourceDomainsRRSetV2Delete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// waitForDomainsRRSetV2Propagation waits until the nameservers return enabled
+// records of the RRSet if wait_for_propagation is set. The nameservers of the
+// zone are used unless propagation_nameservers is set.
+func waitForDomainsRRSetV2Propagation(
+	ctx context.Context, d *schema.ResourceData, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], timeout time.Duration,
+) error {
+	if !d.Get("wait_for_propagation").(bool) {
+		return nil
+	}
+
+	rrsetName := d.Get("name").(string)
+	rrsetType := d.Get("type").(string)
+	if !waiters.RRSetV2PropagationSupported(rrsetType) {
+		log.Printf("[WARN] waiting for propagation of %s RRSets is not supported, skipping it for %s", rrsetType, rrsetName)
+		return nil
+	}
+
+	var contents []string
+	for _, record := range generateRecordsFromSet(d.Get("records").(*schema.Set)) {
+		if !record.Disabled {
+			contents = append(contents, record.Content)
+		}
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+
+	nameservers := expandToStringSlice(d.Get("propagation_nameservers").([]any))
+	if len(nameservers) == 0 {
+		zoneID := d.Get("zone_id").(string)
+		zone, err := client.GetZone(ctx, zoneID, nil)
+		if err != nil {
+			return errGettingObject(objectZone, zoneID, err)
+		}
+		nsRRSet, err := getRRSetByNameAndType(ctx, client, zoneID, zone.Name, string(domainsV2.NS))
+		if err != nil {
+			return err
+		}
+		for _, record := range nsRRSet.Records {
+			nameservers = append(nameservers, record.Content)
+		}
+	}
+
+	log.Printf("[DEBUG] waiting for %s %s to propagate to %v", rrsetName, rrsetType, nameservers)
+
+	return waiters.WaitForRRSetV2Propagated(ctx, nameservers, rrsetName, rrsetType, contents, timeout)
+}
@@ -246,3 +246,44 @@ func testAccDomainsRRSetV2ID(name string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccDomainsRRSetV2WaitForPropagation(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	testRRSetName := fmt.Sprintf("%[1]s.%[2]s", acctest.RandomWithPrefix("tf-acc"), testZoneName)
+	rrsetResourceName := fmt.Sprintf("selectel_domains_rrset_v2.%[1]s", resourceRRSetName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetV2WaitForPropagation(projectName, testRRSetName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDomainsRRSetV2ID(rrsetResourceName),
+					resource.TestCheckResourceAttr(rrsetResourceName, "wait_for_propagation", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRRSetV2WaitForPropagation(projectName, rrsetName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[4]s
+
+	resource "selectel_domains_rrset_v2" %[1]q {
+		name = %[2]q
+		type = "A"
+		ttl = 60
+		zone_id = selectel_domains_zone_v2.%[3]s.id
+		project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+		wait_for_propagation = true
+		records {
+			content = "192.0.2.1"
+		}
+		timeouts {
+			create = "5m"
+		}
+	}`, resourceRRSetName, rrsetName, resourceZoneName, testAccDomainsZoneV2Basic(projectName, resourceZoneName, zoneName))
+}
@@ -0,0 +1,329 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+func resourceDomainsZoneFileV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsZoneFileV2Create,
+		ReadContext:   resourceDomainsZoneFileV2Read,
+		UpdateContext: resourceDomainsZoneFileV2Update,
+		DeleteContext: resourceDomainsZoneFileV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDomainsZoneFileV2ImportState,
+		},
+		CustomizeDiff: resourceDomainsZoneFileV2CustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDomainsZoneFileV2CustomizeDiff plans the RRSets of the zone file,
+// so the changes made outside of Terraform are shown in the plan.
+func resourceDomainsZoneFileV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("zone_id") || !d.NewValueKnown("project_id") {
+		return d.SetNewComputed("rrsets")
+	}
+
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		log.Printf("[WARN] unable to plan zone file RRSets: %s", err)
+		return d.SetNewComputed("rrsets")
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if err != nil {
+		log.Printf("[WARN] unable to plan zone file RRSets: %s", errGettingObject(objectZone, zoneID, err))
+		return d.SetNewComputed("rrsets")
+	}
+
+	rrsets, err := parseZoneFile(d.Get("content").(string), zone.Name)
+	if err != nil {
+		return fmt.Errorf("error parsing zone file: %w", err)
+	}
+
+	return d.SetNew("rrsets", flattenZoneFileRRSets(rrsets))
+}
+
+func resourceDomainsZoneFileV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+
+	desired, err := parseZoneFile(d.Get("content").(string), zone.Name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing zone file: %w", err))
+	}
+
+	err = reconcileZoneFileRRSets(ctx, client, zoneID, desired, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceDomainsZoneFileV2Read(ctx, d, meta)
+}
+
+func resourceDomainsZoneFileV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectZone, d.Id()))
+	zone, err := client.GetZone(ctx, d.Id(), nil)
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectZone, d.Id(), err))
+	}
+
+	desired, err := parseZoneFile(d.Get("content").(string), zone.Name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing zone file: %w", err))
+	}
+
+	actual := make([]zoneFileRRSet, 0, len(desired))
+	for _, rrset := range desired {
+		found, err := getRRSetByNameAndType(ctx, client, zone.ID, rrset.Name, rrset.Type)
+		if errors.Is(err, ErrRRSetNotFound) {
+			continue
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		actual = append(actual, zoneFileRRSetFromAPI(found))
+	}
+
+	d.Set("zone_id", zone.ID)
+	d.Set("rrsets", flattenZoneFileRRSets(actual))
+
+	return nil
+}
+
+func resourceDomainsZoneFileV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(errUpdatingObject(objectZone, d.Id(), err))
+	}
+
+	if d.HasChanges("content", "rrsets") {
+		zone, err := client.GetZone(ctx, d.Id(), nil)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectZone, d.Id(), err))
+		}
+
+		oldContent, newContent := d.GetChange("content")
+
+		desired, err := parseZoneFile(newContent.(string), zone.Name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing zone file: %w", err))
+		}
+
+		previous, err := parseZoneFile(oldContent.(string), zone.Name)
+		if err != nil {
+			log.Printf("[DEBUG] can't parse previous zone file of %s %s: %s", objectZone, d.Id(), err)
+		}
+
+		err = reconcileZoneFileRRSets(ctx, client, d.Id(), desired, previous)
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectZone, d.Id(), err))
+		}
+	}
+
+	return resourceDomainsZoneFileV2Read(ctx, d, meta)
+}
+
+func resourceDomainsZoneFileV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(errDeletingObject(objectZone, d.Id(), err))
+	}
+
+	zone, err := client.GetZone(ctx, d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, d.Id(), err))
+	}
+
+	previous, err := parseZoneFile(d.Get("content").(string), zone.Name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing zone file: %w", err))
+	}
+
+	err = reconcileZoneFileRRSets(ctx, client, d.Id(), nil, previous)
+	if err != nil {
+		return diag.FromErr(errDeletingObject(objectZone, d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceDomainsZoneFileV2ImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if config.ProjectID == "" {
+		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
+	}
+	d.Set("project_id", config.ProjectID)
+
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	// use zone name instead of zone id for importing zone file.
+	// example: terraform import selectel_domains_zone_file_v2.<resource_name> <zone_name>
+	zoneName := d.Id()
+
+	log.Print(msgImport(objectZone, zoneName))
+
+	zone, err := getZoneByName(ctx, client, zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	rrsets, err := listRRSets(ctx, client, zone.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// SOA and NS records of the zone apex are managed by Selectel DNS,
+	// so they are not imported to the zone file.
+	zone.Name = zoneFileFQDN(zone.Name, "")
+	rrsets = slices.DeleteFunc(rrsets, func(rrset *domainsV2.RRSet) bool {
+		return rrset.Type == domainsV2.SOA || (rrset.Type == domainsV2.NS && zoneFileFQDN(rrset.Name, "") == zone.Name)
+	})
+
+	d.SetId(zone.ID)
+	d.Set("zone_id", zone.ID)
+	d.Set("content", renderZoneFile(zone.Name, rrsets))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// reconcileZoneFileRRSets creates and updates the desired RRSets and deletes
+// the previously managed RRSets that are not desired anymore. Other RRSets of
+// the zone aren't changed.
+func reconcileZoneFileRRSets(
+	ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet],
+	zoneID string, desired, previous []zoneFileRRSet,
+) error {
+	desiredKeys := make(map[string]struct{}, len(desired))
+
+	for _, rrset := range desired {
+		desiredKeys[rrset.key()] = struct{}{}
+
+		records := make([]domainsV2.RecordItem, 0, len(rrset.Records))
+		for _, content := range rrset.Records {
+			records = append(records, domainsV2.RecordItem{Content: content})
+		}
+		opts := domainsV2.RRSet{
+			Name:      rrset.Name,
+			Type:      domainsV2.RecordType(rrset.Type),
+			TTL:       rrset.TTL,
+			ZoneID:    zoneID,
+			ManagedBy: domainsV2ManagedBy,
+			Records:   records,
+		}
+
+		existing, err := getRRSetByNameAndType(ctx, client, zoneID, rrset.Name, rrset.Type)
+		switch {
+		case errors.Is(err, ErrRRSetNotFound):
+			log.Print(msgCreate(objectRRSet, opts))
+			if _, err := client.CreateRRSet(ctx, zoneID, &opts); err != nil {
+				return errCreatingObject(objectRRSet, err)
+			}
+		case err != nil:
+			return err
+		case !zoneFileRRSetsEqual(rrset, zoneFileRRSetFromAPI(existing)):
+			opts.Records = append(opts.Records, zoneFileDisabledRecords(existing, rrset)...)
+			opts.Comment = existing.Comment
+			if existing.ManagedBy != "" {
+				opts.ManagedBy = existing.ManagedBy
+			}
+			log.Print(msgUpdate(objectRRSet, existing.ID, opts))
+			if err := client.UpdateRRSet(ctx, zoneID, existing.ID, &opts); err != nil {
+				return errUpdatingObject(objectRRSet, existing.ID, err)
+			}
+		}
+	}
+
+	for _, rrset := range previous {
+		if _, ok := desiredKeys[rrset.key()]; ok {
+			continue
+		}
+
+		existing, err := getRRSetByNameAndType(ctx, client, zoneID, rrset.Name, rrset.Type)
+		if errors.Is(err, ErrRRSetNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Print(msgDelete(objectRRSet, existing.ID))
+		if err := client.DeleteRRSet(ctx, zoneID, existing.ID); err != nil {
+			return errDeletingObject(objectRRSet, existing.ID, err)
+		}
+	}
+
+	return nil
+}
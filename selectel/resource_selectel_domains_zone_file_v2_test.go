@@ -0,0 +1,57 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const resourceZoneFileName = "zone_file_tf_acc_test_1"
+
+func TestAccDomainsZoneFileV2Basic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	resourceName := fmt.Sprintf("selectel_domains_zone_file_v2.%[1]s", resourceZoneFileName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneFileV2Basic(projectName, testZoneName, "www 60 IN A 192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", fmt.Sprintf("selectel_domains_zone_v2.%[1]s", resourceZoneName), "id"),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.name", "www."+testZoneName),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.records.0", "192.0.2.1"),
+				),
+			},
+			{
+				Config: testAccDomainsZoneFileV2Basic(projectName, testZoneName, "mail 120 IN MX 10 mx"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rrsets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.name", "mail."+testZoneName),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "rrsets.0.records.0", "10 mx."+testZoneName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneFileV2Basic(projectName, zoneName, records string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "selectel_domains_zone_file_v2" %[2]q {
+		zone_id = selectel_domains_zone_v2.%[3]s.id
+		project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+		content = <<-EOT
+			$TTL 3600
+			%[4]s
+		EOT
+	}`, testAccDomainsZoneV2Basic(projectName, resourceZoneName, zoneName), resourceZoneFileName, resourceZoneName, records)
+}
@@ -3,11 +3,17 @@ package selectel
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	waiters "github.com/terraform-providers/terraform-provider-selectel/selectel/waiters/domains"
 )
 
 var ErrZoneNotFound = errors.New("zone not found")
@@ -21,6 +27,11 @@ func resourceDomainsZoneV2() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDomainsZoneV2ImportState,
 		},
+		CustomizeDiff: resourceDomainsZoneV2CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -61,6 +72,52 @@ func resourceDomainsZoneV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_delegation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"managed_rrsets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]+/[A-Za-z]+$`), "must be in the <rrset_name>/<rrset_type> format"),
+				},
+			},
+			"ignore_patterns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+			"unmanaged_rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -101,14 +158,39 @@ func resourceDomainsZoneV2Create(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	var waitErr error
+	if d.Get("wait_for_delegation").(bool) {
+		log.Printf("[DEBUG] waiting for zone %s to be delegated", zone.ID)
+		waitErr = waiters.WaitForZoneV2Delegated(ctx, client, zone.ID, d.Timeout(schema.TimeoutCreate))
+
+		zoneID := zone.ID
+		zone, err = client.GetZone(ctx, zoneID, nil)
+		if err != nil {
+			d.SetId(zoneID)
+			return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+		}
+	}
+
 	err = setZoneToResourceData(d, zone)
 	if err != nil {
 		return diag.FromErr(errCreatingObject(objectZone, err))
 	}
 
+	if waitErr != nil {
+		return diag.FromErr(errZoneV2NotDelegated(zone.ID, waitErr))
+	}
+
 	return nil
 }
 
+// errZoneV2NotDelegated reports that the zone isn't delegated yet. The zone
+// stays in the state with last_check_status set to false, so the next apply
+// waits for the delegation again.
+func errZoneV2NotDelegated(zoneID string, err error) error {
+	return fmt.Errorf("zone %s was not delegated to Selectel NS servers within the timeout, "+
+		"the next apply waits for the delegation again: %w", zoneID, err)
+}
+
 func resourceDomainsZoneV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client, err := getDomainsV2Client(d, meta)
 	if err != nil {
@@ -128,6 +210,15 @@ func resourceDomainsZoneV2Read(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(errGettingObject(objectZone, zoneName, err))
 	}
 
+	if d.Get("authoritative").(bool) {
+		err = setUnmanagedRRSetsToResourceData(ctx, d, client, zone.ID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectZone, zoneName, err))
+		}
+	} else {
+		d.Set("unmanaged_rrsets", []any{})
+	}
+
 	return nil
 }
 
@@ -137,6 +228,8 @@ func resourceDomainsZoneV2ImportState(ctx context.Context, d *schema.ResourceDat
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
 	}
 	d.Set("project_id", config.ProjectID)
+	d.Set("authoritative", false)
+	d.Set("wait_for_delegation", false)
 
 	client, err := getDomainsV2Client(d, meta)
 	if err != nil {
@@ -147,6 +240,15 @@ func resourceDomainsZoneV2ImportState(ctx context.Context, d *schema.ResourceDat
 	// example: terraform import domains_zone_v2.<resource_name> <zone_name>
 	zoneName := d.Id()
 
+	// ID of selectel_domains_domain_v1 is also accepted to migrate domains to zones.
+	// example: terraform import domains_zone_v2.<resource_name> <domain_id>
+	if domainID, err := strconv.Atoi(zoneName); err == nil {
+		zoneName, err = getDomainsV1DomainName(ctx, meta, domainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	log.Println(msgImport(objectZone, zoneName))
 
 	zone, err := getZoneByName(ctx, client, zoneName)
@@ -194,6 +296,33 @@ func resourceDomainsZoneV2Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.Get("authoritative").(bool) && d.HasChanges("authoritative", "unmanaged_rrsets") {
+		err = deleteUnmanagedRRSets(ctx, d, client)
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectZone, d.Id(), err))
+		}
+	}
+
+	lastCheckStatus, _ := d.GetChange("last_check_status")
+	if d.Get("wait_for_delegation").(bool) && (d.HasChange("wait_for_delegation") || !lastCheckStatus.(bool)) {
+		log.Printf("[DEBUG] waiting for zone %s to be delegated", d.Id())
+		waitErr := waiters.WaitForZoneV2Delegated(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		zone, err := client.GetZone(ctx, d.Id(), nil)
+		if err != nil {
+			d.Set("last_check_status", lastCheckStatus)
+			return diag.FromErr(errGettingObject(objectZone, d.Id(), err))
+		}
+		err = setZoneToResourceData(d, zone)
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectZone, d.Id(), err))
+		}
+
+		if waitErr != nil {
+			return diag.FromErr(errZoneV2NotDelegated(d.Id(), waitErr))
+		}
+	}
+
 	return nil
 }
 
@@ -212,3 +341,103 @@ func resourceDomainsZoneV2Delete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// resourceDomainsZoneV2CustomizeDiff plans a new wait for the delegation
+// while the zone isn't delegated and deletion of the unmanaged RRSets found
+// during the last refresh when the zone is in the authoritative mode.
+// Unmanaged RRSets are only listed after the mode is enabled, so they are
+// deleted by the next apply after they are shown in the plan.
+func resourceDomainsZoneV2CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.Get("wait_for_delegation").(bool) && !d.Get("last_check_status").(bool) {
+		if err := d.SetNewComputed("last_check_status"); err != nil {
+			return err
+		}
+	}
+	if !d.Get("authoritative").(bool) {
+		if len(d.Get("unmanaged_rrsets").([]any)) > 0 {
+			return d.SetNew("unmanaged_rrsets", []any{})
+		}
+
+		return nil
+	}
+	if d.HasChange("authoritative") {
+		return d.SetNewComputed("unmanaged_rrsets")
+	}
+	if !d.NewValueKnown("managed_rrsets") || !d.NewValueKnown("ignore_patterns") {
+		return d.SetNewComputed("unmanaged_rrsets")
+	}
+
+	filter, err := newDomainsZoneV2RRSetFilter(d)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range d.Get("unmanaged_rrsets").([]any) {
+		rrset := v.(map[string]any)
+		if filter.isUnmanaged(rrset["name"].(string), rrset["type"].(string), "") {
+			return d.SetNew("unmanaged_rrsets", []any{})
+		}
+	}
+
+	return nil
+}
+
+func setUnmanagedRRSetsToResourceData(ctx context.Context, d *schema.ResourceData, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string) error {
+	filter, err := newDomainsZoneV2RRSetFilter(d)
+	if err != nil {
+		return err
+	}
+
+	unmanaged, err := listUnmanagedRRSets(ctx, client, zoneID, filter)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("unmanaged_rrsets", flattenUnmanagedRRSets(unmanaged))
+}
+
+// deleteUnmanagedRRSets deletes RRSets that were shown in the plan as
+// unmanaged and are still unmanaged. RRSets created after the plan are kept
+// until the next plan shows them.
+func deleteUnmanagedRRSets(ctx context.Context, d *schema.ResourceData, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet]) error {
+	filter, err := newDomainsZoneV2RRSetFilter(d)
+	if err != nil {
+		return err
+	}
+
+	unmanaged, err := listUnmanagedRRSets(ctx, client, d.Id(), filter)
+	if err != nil {
+		return err
+	}
+	unmanagedByID := make(map[string]*domainsV2.RRSet, len(unmanaged))
+	for _, rrset := range unmanaged {
+		unmanagedByID[rrset.ID] = rrset
+	}
+
+	planned, _ := d.GetChange("unmanaged_rrsets")
+	for _, v := range planned.([]any) {
+		rrsetID := v.(map[string]any)["id"].(string)
+		if _, ok := unmanagedByID[rrsetID]; !ok {
+			continue
+		}
+
+		log.Println(msgDelete(objectRRSet, rrsetID))
+		err = client.DeleteRRSet(ctx, d.Id(), rrsetID)
+		if err != nil {
+			return errDeletingObject(objectRRSet, rrsetID, err)
+		}
+		delete(unmanagedByID, rrsetID)
+	}
+
+	remaining := make([]*domainsV2.RRSet, 0, len(unmanagedByID))
+	for _, rrset := range unmanaged {
+		if _, ok := unmanagedByID[rrset.ID]; ok {
+			remaining = append(remaining, rrset)
+		}
+	}
+
+	return d.Set("unmanaged_rrsets", flattenUnmanagedRRSets(remaining))
+}
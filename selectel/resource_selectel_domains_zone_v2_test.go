@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
 )
 
 const resourceZoneName = "zone_tf_acc_test_1"
@@ -89,3 +90,92 @@ func testAccDomainsZoneV2Exists(name string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccDomainsZoneV2Authoritative(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.xyz.", acctest.RandomWithPrefix("tf-acc"))
+	zoneResourceName := fmt.Sprintf("selectel_domains_zone_v2.%[1]s", resourceZoneName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneV2Authoritative(projectName, resourceZoneName, testZoneName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDomainsZoneV2Exists(zoneResourceName),
+					testAccDomainsZoneV2CreateRRSet(zoneResourceName, "unmanaged."+testZoneName),
+				),
+			},
+			{
+				Config: testAccDomainsZoneV2Authoritative(projectName, resourceZoneName, testZoneName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(zoneResourceName, "unmanaged_rrsets.#", "0"),
+				),
+			},
+			{
+				Config: testAccDomainsZoneV2Authoritative(projectName, resourceZoneName, testZoneName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(zoneResourceName, "unmanaged_rrsets.#", "1"),
+					resource.TestCheckResourceAttr(zoneResourceName, "unmanaged_rrsets.0.name", "unmanaged."+testZoneName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDomainsZoneV2Authoritative(projectName, resourceZoneName, testZoneName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(zoneResourceName, "authoritative", "true"),
+					resource.TestCheckResourceAttr(zoneResourceName, "unmanaged_rrsets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneV2Authoritative(projectName, resourceName, zoneName string, authoritative bool) string {
+	return fmt.Sprintf(`
+		resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+			name = %[1]q
+		}
+		resource "selectel_domains_zone_v2" %[2]q {
+			name = %[3]q
+			project_id = selectel_vpc_project_v2.project_tf_acc_test_1.id
+			authoritative = %[4]t
+			managed_rrsets = ["www/A"]
+			ignore_patterns = ["^_acme-challenge\\."]
+		}
+		resource "selectel_domains_rrset_v2" "www_tf_acc_test_1" {
+			name = "www.%[3]s"
+			type = "A"
+			ttl = 60
+			zone_id = selectel_domains_zone_v2.%[2]s.id
+			project_id = selectel_vpc_project_v2.project_tf_acc_test_1.id
+			records {
+				content = "192.0.2.1"
+			}
+		}`, projectName, resourceName, zoneName, authoritative)
+}
+
+func testAccDomainsZoneV2CreateRRSet(zoneResourceName, rrsetName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[zoneResourceName]
+		if !ok {
+			return fmt.Errorf("can't find zone: %s", zoneResourceName)
+		}
+
+		client, err := getDomainsV2ClientTest(rs, testAccProvider)
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateRRSet(context.Background(), rs.Primary.ID, &domainsV2.RRSet{
+			Name: rrsetName,
+			Type: domainsV2.TXT,
+			TTL:  60,
+			Records: []domainsV2.RecordItem{
+				{Content: "\"created outside of terraform\""},
+			},
+		})
+
+		return err
+	}
+}
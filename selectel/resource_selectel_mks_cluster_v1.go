@@ -43,6 +43,8 @@ func resourceMKSClusterV1() *schema.Resource {
 				func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
 					return d.HasChange("maintenance_window_start")
 				}),
+			resourceMKSClusterV1CustomizeDiffQuotas,
+			resourceMKSClusterV1CustomizeDiffKubeconfig,
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -269,6 +271,31 @@ func resourceMKSClusterV1() *schema.Resource {
 					},
 				},
 			},
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"server": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_cert": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_cert": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -287,7 +314,7 @@ func resourceMKSClusterV1Create(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("can't get project-scope selvpc for cluster object: %w", err))
 	}
-	err = validateRegion(selvpcClient, MKS, region)
+	err = config.validateServiceRegion(selvpcClient, MKS, region)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
@@ -366,6 +393,10 @@ func resourceMKSClusterV1Create(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(newCluster.ID)
 
+	if err := setMKSClusterV1Kubeconfig(ctx, d, mksClient); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceMKSClusterV1Read(ctx, d, meta)
 }
 
@@ -411,6 +442,14 @@ func resourceMKSClusterV1Read(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(featureGatesKey, mksCluster.KubernetesOptions.FeatureGates)
 	d.Set(admissionControllersKey, mksCluster.KubernetesOptions.AdmissionControllers)
 
+	// Kubeconfig is refreshed after create and upgrades, request it here only
+	// for imported clusters and clusters created by previous provider versions.
+	if d.Get("raw_config").(string) == "" {
+		if err := setMKSClusterV1Kubeconfig(ctx, d, mksClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -424,6 +463,11 @@ func resourceMKSClusterV1Update(ctx context.Context, d *schema.ResourceData, met
 		if err := upgradeMKSClusterV1KubeVersion(ctx, d, mksClient); err != nil {
 			return diag.FromErr(errUpdatingObject(objectCluster, d.Id(), err))
 		}
+
+		// Cluster certificates are reissued during the upgrade.
+		if err := setMKSClusterV1Kubeconfig(ctx, d, mksClient); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	var updateOpts cluster.UpdateOpts
@@ -523,7 +567,13 @@ func resourceMKSClusterV1ImportState(_ context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
-func inferClusterType(d *schema.ResourceData, zonal bool) cluster.ClusterType {
+// resourceGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type resourceGetter interface {
+	GetOk(key string) (any, bool)
+}
+
+func inferClusterType(d resourceGetter, zonal bool) cluster.ClusterType {
 	if v, ok := d.GetOk("cluster_type"); ok {
 		return cluster.ClusterType(strings.ToUpper(v.(string)))
 	}
@@ -533,3 +583,76 @@ func inferClusterType(d *schema.ResourceData, zonal bool) cluster.ClusterType {
 
 	return cluster.ClusterTypeHighAvailability
 }
+
+// resourceMKSClusterV1CustomizeDiffQuotas checks project quotas for a new
+// cluster during the plan so that the lack of quotas is reported before apply.
+func resourceMKSClusterV1CustomizeDiffQuotas(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	clusterType, ok := mksClusterV1PlannedType(d)
+	if !ok || mksDiffValuesUnknown(d, "project_id", "region") {
+		log.Print("[DEBUG] skipping cluster quotas check since some values are not known until apply")
+		return nil
+	}
+
+	config := meta.(*Config)
+	projectID := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	selvpcClient, err := config.GetSelVPCClientWithProjectScope(projectID)
+	if err != nil {
+		log.Printf("[WARN] unable to check cluster quotas during the plan: %s", err)
+		return nil
+	}
+
+	projectQuotas, _, err := quotas.GetProjectQuotas(
+		selvpcClient,
+		projectID,
+		region,
+	)
+	if err != nil {
+		log.Printf("[WARN] unable to check cluster quotas during the plan: %s", errGettingObject(objectProjectQuotas, projectID, err))
+		return nil
+	}
+
+	if err := checkQuotasForCluster(projectQuotas, clusterType); err != nil {
+		return fmt.Errorf("cluster quotas check failed: %w", err)
+	}
+
+	return nil
+}
+
+// mksClusterV1PlannedType returns the type of a new cluster from cluster_type
+// or the deprecated zonal, whichever is known during the plan. Both are
+// Optional and Computed, so the one that is not set is unknown.
+func mksClusterV1PlannedType(d *schema.ResourceDiff) (cluster.ClusterType, bool) {
+	if d.NewValueKnown("cluster_type") {
+		if v, ok := d.GetOk("cluster_type"); ok {
+			return cluster.ClusterType(strings.ToUpper(v.(string))), true
+		}
+	}
+	if d.NewValueKnown("zonal") {
+		return inferClusterType(d, d.Get("zonal").(bool)), true
+	}
+
+	return "", false
+}
+
+// resourceMKSClusterV1CustomizeDiffKubeconfig marks kubeconfig attributes as
+// unknown when the cluster is upgraded, so that dependent providers get the
+// new credentials.
+func resourceMKSClusterV1CustomizeDiffKubeconfig(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("kube_version") {
+		return nil
+	}
+
+	for _, key := range mksClusterV1KubeconfigKeys {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -67,6 +67,8 @@ func TestAccMKSClusterV1Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("selectel_mks_cluster_v1.cluster_tf_acc_test_1", "oidc.0.username_claim", ""),
 					resource.TestCheckResourceAttr("selectel_mks_cluster_v1.cluster_tf_acc_test_1", "oidc.0.groups_claim", ""),
 					resource.TestCheckResourceAttr("selectel_mks_cluster_v1.cluster_tf_acc_test_1", "oidc.0.ca_certs", ""),
+					resource.TestCheckResourceAttrSet("selectel_mks_cluster_v1.cluster_tf_acc_test_1", "raw_config"),
+					resource.TestCheckResourceAttrSet("selectel_mks_cluster_v1.cluster_tf_acc_test_1", "server"),
 				),
 			},
 			{
@@ -654,7 +656,7 @@ func newTestMKSClientWithParams(projectID, region string) (*v1.ServiceClient, er
 		return nil, fmt.Errorf("can't get endpoint for mks acc tests: %w", err)
 	}
 
-	mksClient := v1.NewMKSClientV1(selvpcClient.GetXAuthToken(), endpoint.URL)
+	mksClient := v1.NewMKSClientV1(config.GetXAuthToken(selvpcClient), endpoint.URL)
 
 	return mksClient, nil
 }
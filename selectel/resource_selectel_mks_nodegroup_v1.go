@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/quotamanager/quotas"
+	v1 "github.com/selectel/mks-go/pkg/v1"
+	"github.com/selectel/mks-go/pkg/v1/cluster"
 	"github.com/selectel/mks-go/pkg/v1/nodegroup"
 )
 
@@ -177,6 +178,26 @@ func resourceMKSNodegroupV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kube_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(v any) string {
+					return strings.TrimPrefix(v.(string), "v")
+				},
+			},
+			"max_unavailable": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_surge": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"nodes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -206,6 +227,8 @@ func resourceMKSNodegroupV1() *schema.Resource {
 			customdiff.ForceNewIfChange("local_volume", func(_ context.Context, oldVersion, newVersion, _ any) bool {
 				return oldVersion.(bool) != newVersion.(bool)
 			}),
+			resourceMKSNodegroupV1CustomizeDiffUpgradeBudget,
+			resourceMKSNodegroupV1CustomizeDiffQuotas,
 		),
 	}
 }
@@ -229,7 +252,7 @@ func resourceMKSNodegroupV1Create(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(fmt.Errorf("can't get project-scope selvpc for node group object: %w", err))
 	}
 
-	err = validateRegion(selvpcClient, MKS, region)
+	err = config.validateServiceRegion(selvpcClient, MKS, region)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
@@ -248,6 +271,17 @@ func resourceMKSNodegroupV1Create(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// New nodes get the cluster version, so the nodegroup version is only checked.
+	if kubeVersion := d.Get("kube_version").(string); kubeVersion != "" {
+		mksCluster, _, err := cluster.Get(ctx, mksClient, clusterID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectCluster, clusterID, err))
+		}
+		if err := checkMKSNodegroupV1KubeVersion(mksCluster.KubeVersion, kubeVersion); err != nil {
+			return diag.FromErr(errCreatingObject(objectNodegroup, err))
+		}
+	}
+
 	// Prepare nodegroup create options.
 	installNvidiaDevicePlugin := d.Get("install_nvidia_device_plugin").(bool)
 	preemptible := d.Get("preemptible").(bool)
@@ -273,26 +307,11 @@ func resourceMKSNodegroupV1Create(ctx context.Context, d *schema.ResourceData, m
 	if !createOpts.LocalVolume && createOpts.VolumeType == "" {
 		return diag.FromErr(fmt.Errorf("can't use local_volume=false without specify volume_type: %w", err))
 	}
-	filters := []func(url.Values){
-		quotas.WithResourceFilter("compute_cores"),
-		quotas.WithResourceFilter("compute_ram"),
-	}
-	if createOpts.LocalVolume {
-		filters = append(filters, quotas.WithResourceFilter("volume_gigabytes_local"))
-	} else {
-		// Removing an availability zone from volume type.
-		// For example: `fast.ru-3a` -> `fast`.
-		volumeType := strings.Split(createOpts.VolumeType, ".")[0]
-		resourceName := "volume_gigabytes_" + volumeType
-
-		filters = append(filters, quotas.WithResourceFilter(resourceName))
-	}
-
 	projectQuotas, _, err := quotas.GetProjectQuotas(
 		selvpcClient,
 		projectID,
 		region,
-		filters...,
+		mksNodegroupV1QuotaFilters(createOpts.LocalVolume, createOpts.VolumeType)...,
 	)
 	if err != nil {
 		return diag.FromErr(errGettingObject(objectProjectQuotas, projectID, err))
@@ -402,6 +421,17 @@ func resourceMKSNodegroupV1Read(ctx context.Context, d *schema.ResourceData, met
 		log.Println(errSettingComplexAttr("taints", err))
 	}
 
+	// The API doesn't return the nodegroup version. Nodes get the cluster
+	// version when they are created, so it is used for new, imported and
+	// adopted nodegroups. Later the version is only changed by the upgrade.
+	if d.Get("kube_version").(string) == "" {
+		mksCluster, _, err := cluster.Get(ctx, mksClient, clusterID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectCluster, clusterID, err))
+		}
+		d.Set("kube_version", strings.TrimPrefix(mksCluster.KubeVersion, "v"))
+	}
+
 	return nil
 }
 
@@ -429,7 +459,7 @@ func resourceMKSNodegroupV1Update(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(fmt.Errorf("can't get project-scope selvpc for node group object: %w", err))
 	}
 
-	err = validateRegion(selvpcClient, MKS, region)
+	err = config.validateServiceRegion(selvpcClient, MKS, region)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("can't validate region: %w", err))
 	}
@@ -490,26 +520,11 @@ func resourceMKSNodegroupV1Update(ctx context.Context, d *schema.ResourceData, m
 			AvailabilityZone: d.Get("availability_zone").(string),
 		}
 
-		filters := []func(url.Values){
-			quotas.WithResourceFilter("compute_cores"),
-			quotas.WithResourceFilter("compute_ram"),
-		}
-		if newNodesRequest.LocalVolume {
-			filters = append(filters, quotas.WithResourceFilter("volume_gigabytes_local"))
-		} else {
-			// Removing an availability zone from volume type.
-			// For example: `fast.ru-3a` -> `fast`.
-			volumeType := strings.Split(newNodesRequest.VolumeType, ".")[0]
-			resourceName := "volume_gigabytes_" + volumeType
-
-			filters = append(filters, quotas.WithResourceFilter(resourceName))
-		}
-
 		projectQuotas, _, err := quotas.GetProjectQuotas(
 			selvpcClient,
 			projectID,
 			region,
-			filters...,
+			mksNodegroupV1QuotaFilters(newNodesRequest.LocalVolume, newNodesRequest.VolumeType)...,
 		)
 		if err != nil {
 			return diag.FromErr(errGettingObject(objectProjectQuotas, projectID, err))
@@ -537,9 +552,62 @@ func resourceMKSNodegroupV1Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("kube_version") {
+		if err := updateMKSNodegroupV1KubeVersion(ctx, d, mksClient, clusterID, nodegroupID); err != nil {
+			// Keep the previous version so the upgrade is retried by the next apply.
+			oldVersion, _ := d.GetChange("kube_version")
+			d.Set("kube_version", oldVersion)
+
+			return diag.FromErr(errUpdatingObject(objectNodegroup, d.Id(), err))
+		}
+	}
+
 	return resourceMKSNodegroupV1Read(ctx, d, meta)
 }
 
+// updateMKSNodegroupV1KubeVersion rolls the nodegroup nodes to the version of
+// the cluster control plane.
+func updateMKSNodegroupV1KubeVersion(
+	ctx context.Context, d *schema.ResourceData, mksClient *v1.ServiceClient, clusterID, nodegroupID string,
+) error {
+	oldVersion, newVersion := d.GetChange("kube_version")
+	currentVersion := oldVersion.(string)
+	desiredVersion := newVersion.(string)
+	if desiredVersion == "" {
+		return nil
+	}
+
+	mksCluster, _, err := cluster.Get(ctx, mksClient, clusterID)
+	if err != nil {
+		return errGettingObject(objectCluster, clusterID, err)
+	}
+	if err := checkMKSNodegroupV1KubeVersion(mksCluster.KubeVersion, desiredVersion); err != nil {
+		return err
+	}
+	if !mksNodegroupV1KubeVersionNeedsUpgrade(currentVersion, desiredVersion) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] upgrading nodegroup %s from %q to %s", nodegroupID, currentVersion, desiredVersion)
+	opts := mksNodegroupV1UpgradeOpts{
+		MaxUnavailable: d.Get("max_unavailable").(int),
+		MaxSurge:       d.Get("max_surge").(int),
+		Autoscaled:     d.Get("enable_autoscale").(bool),
+	}
+
+	return upgradeMKSNodegroupV1KubeVersion(ctx, mksClient, clusterID, nodegroupID, opts, d.Timeout(schema.TimeoutUpdate))
+}
+
+// resourceMKSNodegroupV1CustomizeDiffUpgradeBudget checks that the rolling
+// upgrade can make progress.
+func resourceMKSNodegroupV1CustomizeDiffUpgradeBudget(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Get("max_unavailable").(int)+d.Get("max_surge").(int) < 1 {
+		return errors.New("max_unavailable and max_surge can't be both 0")
+	}
+
+	return nil
+}
+
 func resourceMKSNodegroupV1Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	clusterID, nodegroupID, err := mksNodegroupV1ParseID(d.Id())
 	if err != nil {
@@ -604,3 +672,95 @@ func resourceMKSNodegroupV1ImportState(_ context.Context, d *schema.ResourceData
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceMKSNodegroupV1CustomizeDiffQuotas checks project quotas for new
+// nodes during the plan. It covers nodegroup creation and scale-ups with
+// nodes_count and autoscale_max_nodes.
+func resourceMKSNodegroupV1CustomizeDiffQuotas(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChanges("nodes_count", "enable_autoscale", "autoscale_max_nodes") {
+		return nil
+	}
+
+	newNodesRequest, ok := mksNodegroupV1QuotaRequest(d)
+	if !ok {
+		return nil
+	}
+
+	config := meta.(*Config)
+	projectID := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	selvpcClient, err := config.GetSelVPCClientWithProjectScope(projectID)
+	if err != nil {
+		log.Printf("[WARN] unable to check nodegroup quotas during the plan: %s", err)
+		return nil
+	}
+
+	projectQuotas, _, err := quotas.GetProjectQuotas(
+		selvpcClient,
+		projectID,
+		region,
+		mksNodegroupV1QuotaFilters(newNodesRequest.LocalVolume, newNodesRequest.VolumeType)...,
+	)
+	if err != nil {
+		log.Printf("[WARN] unable to check nodegroup quotas during the plan: %s", errGettingObject(objectProjectQuotas, projectID, err))
+		return nil
+	}
+
+	if err := checkQuotasForNodegroup(projectQuotas, newNodesRequest); err != nil {
+		return fmt.Errorf("nodegroup quotas check failed for %d new nodes: %w", newNodesRequest.Count, err)
+	}
+
+	return nil
+}
+
+// mksNodegroupV1QuotaRequest returns the resources of new nodes to check
+// against project quotas. It returns false if the check is not needed or
+// can't be done during the plan. Optional values that are not known yet,
+// for example, computed values of a new nodegroup, are treated as unset.
+func mksNodegroupV1QuotaRequest(d *schema.ResourceDiff) (*nodegroup.CreateOpts, bool) {
+	if mksDiffValuesUnknown(d, "project_id", "region", "availability_zone", "nodes_count", "cpus", "ram_mb") {
+		log.Print("[DEBUG] skipping nodegroup quotas check since some values are not known until apply")
+		return nil, false
+	}
+
+	// Skip quota validation cause we can not open flavor and check resource claim.
+	if d.Get("cpus").(int) == 0 {
+		return nil, false
+	}
+
+	oldNodesCount, newNodesCount := d.GetChange("nodes_count")
+	oldEnableAutoscale, _ := d.GetChange("enable_autoscale")
+	oldAutoscaleMaxNodes, _ := d.GetChange("autoscale_max_nodes")
+
+	enableAutoscale, _ := d.GetOk("enable_autoscale")
+	autoscaleMaxNodes, _ := d.GetOk("autoscale_max_nodes")
+	volumeGB, _ := d.GetOk("volume_gb")
+	volumeType, _ := d.GetOk("volume_type")
+	localVolume, _ := d.GetOk("local_volume")
+
+	// Create fails without a volume type for network volumes, so there is
+	// nothing to check.
+	if !localVolume.(bool) && volumeType.(string) == "" {
+		return nil, false
+	}
+
+	var currentMaxNodes int
+	if d.Id() != "" {
+		currentMaxNodes = mksNodegroupV1MaxNodes(oldNodesCount.(int), oldEnableAutoscale.(bool), oldAutoscaleMaxNodes.(int))
+	}
+	desiredMaxNodes := mksNodegroupV1MaxNodes(newNodesCount.(int), enableAutoscale.(bool), autoscaleMaxNodes.(int))
+	if desiredMaxNodes <= currentMaxNodes {
+		return nil, false
+	}
+
+	return &nodegroup.CreateOpts{
+		Count:            desiredMaxNodes - currentMaxNodes,
+		CPUs:             d.Get("cpus").(int),
+		RAMMB:            d.Get("ram_mb").(int),
+		VolumeGB:         volumeGB.(int),
+		VolumeType:       volumeType.(string),
+		LocalVolume:      localVolume.(bool),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}, true
+}
@@ -20,9 +20,49 @@ func resourceDBaaSUserV1Schema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"password": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"generate_password"},
+		},
+		"generate_password": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"rotation_trigger": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rotation_period": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+		},
+		"password_rotated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"secret": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"stored_secret_key": {
+			Type:     schema.TypeString,
+			Computed: true,
 		},
 		"status": {
 			Type:     schema.TypeString,
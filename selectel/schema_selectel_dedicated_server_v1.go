@@ -43,9 +43,13 @@ const (
 	dedicatedServerSchemaPrivateIP                   = "private_ip"
 	dedicatedServerSchemaAddPrivateVlan              = "add_private_vlan"
 	dedicatedServerSchemaPrivateVlan                 = "private_vlan"
+	dedicatedServerSchemaKeyDeletionProtection       = "deletion_protection"
+	dedicatedServerSchemaKeyOnDestroy                = "on_destroy"
 	dedicatedServerPowerStateOn                      = "on"
 	dedicatedServerPowerStateOff                     = "off"
 	dedicatedServerPowerActionReboot                 = "reboot"
+	dedicatedServerOnDestroyDelete                   = "delete"
+	dedicatedServerOnDestroyAbandon                  = "abandon"
 )
 
 func resourceDedicatedServerV1Schema() map[string]*schema.Schema {
@@ -241,6 +245,22 @@ func resourceDedicatedServerV1Schema() map[string]*schema.Schema {
 			Optional: true,
 		},
 
+		// optional lifecycle params
+		dedicatedServerSchemaKeyDeletionProtection: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		dedicatedServerSchemaKeyOnDestroy: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  dedicatedServerOnDestroyDelete,
+			ValidateFunc: validation.StringInSlice([]string{
+				dedicatedServerOnDestroyDelete,
+				dedicatedServerOnDestroyAbandon,
+			}, false),
+		},
+
 		// computed attributes
 		dedicatedServerSchemaPublicIP: {
 			Type:     schema.TypeString,
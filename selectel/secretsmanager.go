@@ -16,23 +16,23 @@ func getSecretsManagerClient(d *schema.ResourceData, meta any) (*secretsmanager.
 		return nil, diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for secretsmanager: %w", err))
 	}
 
-	endpointSM, err := selvpcClient.Catalog.GetEndpoint(SecretsManager, config.AuthRegion)
+	endpointSM, err := config.getEndpoint(selvpcClient, SecretsManager, config.AuthRegion)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get %s endpoint to init secretsmanager client: %w", SecretsManager, err))
 	}
 
-	endpointCM, err := selvpcClient.Catalog.GetEndpoint(CertificateManager, config.AuthRegion)
+	endpointCM, err := config.getEndpoint(selvpcClient, CertificateManager, config.AuthRegion)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't get %s endpoint to init secretsmanager client: %w", CertificateManager, err))
 	}
 
 	cl, err := secretsmanager.New(
 		secretsmanager.WithAuthOpts(
-			&secretsmanager.AuthOpts{KeystoneToken: selvpcClient.GetXAuthToken()},
+			&secretsmanager.AuthOpts{KeystoneToken: config.GetXAuthToken(selvpcClient)},
 		),
-
-		secretsmanager.WithCustomURLSecrets(endpointSM.URL),
-		secretsmanager.WithCustomURLCertificates(endpointCM.URL),
+		secretsmanager.WithCustomHTTPClient(config.GetHTTPClient(SecretsManager)),
+		secretsmanager.WithCustomURLSecrets(endpointSM),
+		secretsmanager.WithCustomURLCertificates(endpointCM),
 	)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't init secretsmanager client: %w", err))
@@ -51,8 +51,9 @@ func getSecretsManagerClientForAccImportTests(meta any) (*secretsmanager.Client,
 
 	cl, err := secretsmanager.New(
 		secretsmanager.WithAuthOpts(
-			&secretsmanager.AuthOpts{KeystoneToken: selvpcClient.GetXAuthToken()},
+			&secretsmanager.AuthOpts{KeystoneToken: config.GetXAuthToken(selvpcClient)},
 		),
+		secretsmanager.WithCustomHTTPClient(config.GetHTTPClient(SecretsManager)),
 	)
 	if err != nil {
 		return nil, diag.FromErr(fmt.Errorf("can't init secretsmanager client: %w", err))
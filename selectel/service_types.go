@@ -14,3 +14,10 @@ const (
 	GlobalRouter       = "global-router"
 	PublicNetAPI       = "public-net-api"
 )
+
+// Services that are not registered in the Keystone catalog. They are used as
+// keys of the endpoint overrides only.
+const (
+	DedicatedServers = "dedicated-servers"
+	DomainsV1        = "domains"
+)
@@ -0,0 +1,276 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+const (
+	zoneV2Delegated    = "delegated"
+	zoneV2NotDelegated = "not_delegated"
+
+	rrsetV2Propagated    = "propagated"
+	rrsetV2NotPropagated = "not_propagated"
+
+	defaultNameserverPort = "53"
+)
+
+// propagationLookupTypes are RRSet types, which content can be checked with
+// the Go resolver.
+var propagationLookupTypes = []domainsV2.RecordType{
+	domainsV2.A,
+	domainsV2.AAAA,
+	domainsV2.CNAME,
+	domainsV2.MX,
+	domainsV2.SRV,
+	domainsV2.TXT,
+}
+
+func WaitForZoneV2Delegated(
+	ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string, timeout time.Duration,
+) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			zoneV2NotDelegated,
+		},
+		Target: []string{
+			zoneV2Delegated,
+		},
+		Timeout:    timeout,
+		Refresh:    zoneV2DelegationRefreshFunc(ctx, client, zoneID),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the zone %s to become '%s': %w", zoneID, zoneV2Delegated, err)
+	}
+
+	return nil
+}
+
+func zoneV2DelegationRefreshFunc(
+	ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string,
+) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		zone, err := client.GetZone(ctx, zoneID, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		if !zone.LastCheckStatus {
+			return zone, zoneV2NotDelegated, nil
+		}
+
+		return zone, zoneV2Delegated, nil
+	}
+}
+
+// RRSetV2PropagationSupported shows if the content of the RRSet type can be
+// checked on nameservers.
+func RRSetV2PropagationSupported(rrsetType string) bool {
+	return slices.Contains(propagationLookupTypes, domainsV2.RecordType(rrsetType))
+}
+
+// WaitForRRSetV2Propagated waits until every nameserver returns the content
+// of the RRSet. Nameservers are host names or addresses with an optional port.
+func WaitForRRSetV2Propagated(
+	ctx context.Context, nameservers []string, name, rrsetType string, contents []string, timeout time.Duration,
+) error {
+	expected, err := normalizeRRSetV2Contents(rrsetType, contents)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			rrsetV2NotPropagated,
+		},
+		Target: []string{
+			rrsetV2Propagated,
+		},
+		Timeout:    timeout,
+		Refresh:    rrsetV2PropagationRefreshFunc(ctx, nameservers, fqdn(name), rrsetType, expected),
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the rrset %s %s to become '%s': %w", name, rrsetType, rrsetV2Propagated, err)
+	}
+
+	return nil
+}
+
+func rrsetV2PropagationRefreshFunc(
+	ctx context.Context, nameservers []string, name, rrsetType string, expected []string,
+) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		for _, nameserver := range nameservers {
+			actual, err := lookupRRSetV2(ctx, newNameserverResolver(nameserver), name, rrsetType)
+			if err != nil {
+				log.Printf("[DEBUG] can't look up %s %s on %s: %s", name, rrsetType, nameserver, err)
+				return nil, rrsetV2NotPropagated, nil
+			}
+			if !slices.Equal(expected, actual) {
+				log.Printf("[DEBUG] %s returns %v for %s %s, expected %v", nameserver, actual, name, rrsetType, expected)
+				return actual, rrsetV2NotPropagated, nil
+			}
+		}
+
+		return expected, rrsetV2Propagated, nil
+	}
+}
+
+// newNameserverResolver returns a resolver that sends all queries to the
+// nameserver.
+func newNameserverResolver(nameserver string) *net.Resolver {
+	address := nameserver
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		address = net.JoinHostPort(strings.TrimSuffix(nameserver, "."), defaultNameserverPort)
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// lookupRRSetV2 returns the sorted content of the RRSet in the format used
+// by the DNS API.
+func lookupRRSetV2(ctx context.Context, resolver *net.Resolver, name, rrsetType string) ([]string, error) {
+	var result []string
+
+	switch domainsV2.RecordType(rrsetType) {
+	case domainsV2.A, domainsV2.AAAA:
+		network := "ip4"
+		if rrsetType == string(domainsV2.AAAA) {
+			network = "ip6"
+		}
+		addrs, err := resolver.LookupNetIP(ctx, network, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			result = append(result, addr.Unmap().String())
+		}
+	case domainsV2.CNAME:
+		cname, err := resolver.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fqdn(cname))
+	case domainsV2.MX:
+		mxs, err := resolver.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range mxs {
+			result = append(result, fmt.Sprintf("%d %s", mx.Pref, fqdn(mx.Host)))
+		}
+	case domainsV2.SRV:
+		_, srvs, err := resolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range srvs {
+			result = append(result, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, fqdn(srv.Target)))
+		}
+	case domainsV2.TXT:
+		txts, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, txts...)
+	default:
+		return nil, fmt.Errorf("lookup of %s records is not supported", rrsetType)
+	}
+	slices.Sort(result)
+
+	return slices.Compact(result), nil
+}
+
+// normalizeRRSetV2Contents converts the content of the records to the format
+// returned by lookupRRSetV2.
+func normalizeRRSetV2Contents(rrsetType string, contents []string) ([]string, error) {
+	result := make([]string, 0, len(contents))
+	for _, content := range contents {
+		fields := strings.Fields(content)
+
+		switch domainsV2.RecordType(rrsetType) {
+		case domainsV2.A, domainsV2.AAAA:
+			addr, err := netip.ParseAddr(content)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s record content %q: %w", rrsetType, content, err)
+			}
+			content = addr.Unmap().String()
+		case domainsV2.CNAME:
+			content = fqdn(content)
+		case domainsV2.MX:
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid MX record content %q", content)
+			}
+			content = fmt.Sprintf("%s %s", fields[0], fqdn(fields[1]))
+		case domainsV2.SRV:
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("invalid SRV record content %q", content)
+			}
+			content = fmt.Sprintf("%s %s %s %s", fields[0], fields[1], fields[2], fqdn(fields[3]))
+		case domainsV2.TXT:
+			text, err := unquoteTXT(content)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TXT record content %q: %w", content, err)
+			}
+			content = text
+		default:
+			return nil, fmt.Errorf("lookup of %s records is not supported", rrsetType)
+		}
+		result = append(result, content)
+	}
+	slices.Sort(result)
+
+	return slices.Compact(result), nil
+}
+
+// unquoteTXT joins quoted character strings of the TXT record content.
+func unquoteTXT(content string) (string, error) {
+	var sb strings.Builder
+	rest := strings.TrimSpace(content)
+	for rest != "" {
+		quoted, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return "", err
+		}
+		text, err := strconv.Unquote(quoted)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(text)
+		rest = strings.TrimSpace(rest[len(quoted):])
+	}
+
+	return sb.String(), nil
+}
+
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
@@ -0,0 +1,121 @@
+package domains
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// startStubNameserver starts an authoritative nameserver on a local UDP port
+// that answers with the given resources.
+func startStubNameserver(t *testing.T, answers map[dnsmessage.Type][]dnsmessage.Resource) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			var query dnsmessage.Message
+			if err := query.Unpack(buf[:n]); err != nil || len(query.Questions) != 1 {
+				continue
+			}
+			question := query.Questions[0]
+
+			response := dnsmessage.Message{
+				Header: dnsmessage.Header{
+					ID:            query.ID,
+					Response:      true,
+					Authoritative: true,
+				},
+				Questions: query.Questions,
+			}
+			for _, answer := range answers[question.Type] {
+				answer.Header.Name = question.Name
+				answer.Header.Class = dnsmessage.ClassINET
+				answer.Header.TTL = 60
+				response.Answers = append(response.Answers, answer)
+			}
+
+			packed, err := response.Pack()
+			if err != nil {
+				continue
+			}
+			conn.WriteTo(packed, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestWaitForRRSetV2Propagated(t *testing.T) {
+	nameserver := startStubNameserver(t, map[dnsmessage.Type][]dnsmessage.Resource{
+		dnsmessage.TypeA: {
+			{Body: &dnsmessage.AResource{A: [4]byte{192, 0, 2, 2}}},
+			{Body: &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}}},
+		},
+		dnsmessage.TypeTXT: {
+			{Body: &dnsmessage.TXTResource{TXT: []string{"v=spf1 ", "-all"}}},
+		},
+		dnsmessage.TypeMX: {
+			{Body: &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.example.com.")}},
+		},
+	})
+	ctx := context.Background()
+
+	err := WaitForRRSetV2Propagated(ctx, []string{nameserver}, "www.example.com", "A", []string{"192.0.2.1", "192.0.2.2"}, 10*time.Second)
+	assert.NoError(t, err)
+
+	err = WaitForRRSetV2Propagated(ctx, []string{nameserver}, "example.com.", "TXT", []string{`"v=spf1 " "-all"`}, 10*time.Second)
+	assert.NoError(t, err)
+
+	err = WaitForRRSetV2Propagated(ctx, []string{nameserver}, "example.com.", "MX", []string{"10 Mail.example.com"}, 10*time.Second)
+	assert.NoError(t, err)
+
+	err = WaitForRRSetV2Propagated(ctx, []string{nameserver}, "www.example.com.", "A", []string{"192.0.2.3"}, time.Second)
+	assert.Error(t, err)
+}
+
+func TestNormalizeRRSetV2Contents(t *testing.T) {
+	testCases := []struct {
+		rrsetType string
+		contents  []string
+		expected  []string
+	}{
+		{rrsetType: "A", contents: []string{"192.0.2.2", "192.0.2.1", "192.0.2.1"}, expected: []string{"192.0.2.1", "192.0.2.2"}},
+		{rrsetType: "AAAA", contents: []string{"2001:DB8::1"}, expected: []string{"2001:db8::1"}},
+		{rrsetType: "CNAME", contents: []string{"Origin.example.com"}, expected: []string{"origin.example.com."}},
+		{rrsetType: "MX", contents: []string{"10 mail.example.com."}, expected: []string{"10 mail.example.com."}},
+		{rrsetType: "SRV", contents: []string{"10 20 5060 sip.example.com"}, expected: []string{"10 20 5060 sip.example.com."}},
+		{rrsetType: "TXT", contents: []string{`"hello, " "world \"1\""`}, expected: []string{`hello, world "1"`}},
+	}
+
+	for _, tc := range testCases {
+		actual, err := normalizeRRSetV2Contents(tc.rrsetType, tc.contents)
+		require.NoError(t, err, tc.rrsetType)
+		assert.Equal(t, tc.expected, actual, tc.rrsetType)
+	}
+
+	for rrsetType, content := range map[string]string{"A": "example.com", "MX": "10", "TXT": "unquoted", "CAA": `0 issue "ca.example.net"`} {
+		_, err := normalizeRRSetV2Contents(rrsetType, []string{content})
+		assert.Error(t, err, rrsetType)
+	}
+}
+
+func TestRRSetV2PropagationSupported(t *testing.T) {
+	assert.True(t, RRSetV2PropagationSupported("TXT"))
+	assert.False(t, RRSetV2PropagationSupported("CAA"))
+	assert.False(t, RRSetV2PropagationSupported("NS"))
+}